@@ -3,6 +3,7 @@ package acctest
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -370,6 +371,69 @@ func TestAccAcctestProvider_IgnoreTagsKeys_multiple(t *testing.T) {
 	})
 }
 
+func TestAccAcctestProvider_IgnoreTagsPatterns_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { PreCheck(t) },
+		ErrorCheck:        ErrorCheck(t),
+		ProviderFactories: FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIgnoreTagsPatternsConfig(`-managed-by$`, "Name", `^eks-`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIgnoreTagsPatterns(&providers, []string{`-managed-by$`}, map[string]string{"Name": `^eks-`}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAcctestProvider_RequiredTags_missing(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { PreCheck(t) },
+		ErrorCheck:        ErrorCheck(t),
+		ProviderFactories: ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRequiredTagsConfig("aws_iam_role", "team", "1234"),
+				ExpectError: regexp.MustCompile(`missing required tag keys: owner`),
+			},
+			{
+				Config:      testAccRequiredTagsConfig("aws_iam_role", "owner", "none"),
+				ExpectError: regexp.MustCompile(`tag "cost-center" value "none" does not match any allowed value`),
+			},
+		},
+	})
+}
+
+func TestAccAcctestProvider_RequiredTags_satisfied(t *testing.T) {
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { PreCheck(t) },
+		ErrorCheck:        ErrorCheck(t),
+		ProviderFactories: ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRequiredTagsConfig("aws_iam_role", "owner", "1234"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+				),
+			},
+			{
+				Config: testAccRequiredTagsConfig("aws_vpc", "team", "1234"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAcctestProvider_Region_awsC2S(t *testing.T) {
 	var providers []*schema.Provider
 
@@ -691,6 +755,48 @@ func testAccCheckIgnoreTagsKeys(providers *[]*schema.Provider, expectedKeys []st
 	}
 }
 
+func testAccCheckIgnoreTagsPatterns(providers *[]*schema.Provider, expectedKeyPatterns []string, expectedValuePatterns map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if providers == nil {
+			return fmt.Errorf("no providers initialized")
+		}
+
+		for _, provo := range *providers {
+			if provo == nil || provo.Meta() == nil || provo.Meta().(*conns.AWSClient) == nil {
+				continue
+			}
+
+			ignoreTagsConfig := provo.Meta().(*conns.AWSClient).IgnoreTagsConfig
+
+			if ignoreTagsConfig == nil {
+				return fmt.Errorf("expected ignore_tags configuration, got none")
+			}
+
+			var actualKeyPatterns []string
+
+			for _, re := range ignoreTagsConfig.KeyPatterns {
+				actualKeyPatterns = append(actualKeyPatterns, re.String())
+			}
+
+			if !reflect.DeepEqual(actualKeyPatterns, expectedKeyPatterns) {
+				return fmt.Errorf("expected key_patterns %v, got: %v", expectedKeyPatterns, actualKeyPatterns)
+			}
+
+			actualValuePatterns := make(map[string]string)
+
+			for _, valuePattern := range ignoreTagsConfig.ValuePatterns {
+				actualValuePatterns[valuePattern.Key] = valuePattern.Pattern.String()
+			}
+
+			if !reflect.DeepEqual(actualValuePatterns, expectedValuePatterns) {
+				return fmt.Errorf("expected value_pattern %v, got: %v", expectedValuePatterns, actualValuePatterns)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckProviderDefaultTags_Tags(providers *[]*schema.Provider, expectedTags map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if providers == nil {
@@ -996,6 +1102,57 @@ provider "aws" {
 `)
 }
 
+func testAccIgnoreTagsPatternsConfig(keyPattern, valuePatternKey, valuePattern string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    key_patterns = [%[1]q]
+
+    value_pattern {
+      key     = %[2]q
+      pattern = %[3]q
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, keyPattern, valuePatternKey, valuePattern))
+}
+
+func testAccRequiredTagsConfig(excludeResourceType, tagKey, costCenter string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  required_tags {
+    tag {
+      key            = "cost-center"
+      allowed_values = ["^[0-9]{4}$"]
+    }
+
+    tag {
+      key                    = "owner"
+      exclude_resource_types = [%[1]q]
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    %[2]q         = "test"
+    "cost-center" = %[3]q
+  }
+}
+`, excludeResourceType, tagKey, costCenter)
+}
+
 func testAccIgnoreTagsKeyPrefixes3Config(tagPrefix1 string) string {
 	//lintignore:AT004
 	return ConfigCompose(
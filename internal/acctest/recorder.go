@@ -0,0 +1,822 @@
+package acctest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/rest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// Values of the TF_ACC_RECORD_MODE environment variable.
+const (
+	// Run acceptance tests against AWS and record their API requests
+	RecordModeRecord = "record"
+
+	// Run acceptance tests against previously recorded API requests, without AWS credentials
+	RecordModeReplay = "replay"
+)
+
+// recordingsDir is the directory of the API request recordings, relative to
+// the package of the acceptance test.
+const recordingsDir = "testdata/recordings"
+
+// recording is the fixture file of an acceptance test's API requests.
+type recording struct {
+	AccountID          string         `json:"account_id"`
+	Partition          string         `json:"partition"`
+	SupportedPlatforms []string       `json:"supported_platforms,omitempty"`
+	Interactions       []*interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method       string `json:"method"`
+	URL          string `json:"url"`
+	Target       string `json:"target,omitempty"`
+	Body         string `json:"body,omitempty"`
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"`
+}
+
+// recordingRandomNameRegexp matches names generated by
+// sdkacctest.RandomWithPrefix(ResourcePrefix), which differ between runs.
+var recordingRandomNameRegexp = regexp.MustCompile(regexp.QuoteMeta(ResourcePrefix) + `-\d+`)
+
+// recordingSensitive replaces sensitive values in recorded API requests and responses.
+const recordingSensitive = "{{sensitive}}"
+
+// recordingSensitiveMinLength is the minimum length of redacted sensitive
+// values. Shorter values would likely also replace unrelated parts of requests.
+const recordingSensitiveMinLength = 4
+
+// recordingNormalizers replace the parts of API requests that differ between
+// runs with placeholders before requests are matched.
+var recordingNormalizers = []struct {
+	regexp      *regexp.Regexp
+	placeholder string
+}{
+	{recordingRandomNameRegexp, "{{random}}"},
+	// ISO 8601 timestamps, including URL encoded ones.
+	{regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}(:|%3A)\d{2}(:|%3A)\d{2}(\.\d+)?(Z|[+-]\d{2}(:|%3A)?\d{2})?`), "{{timestamp}}"},
+	{regexp.MustCompile(`\b\d{8}T\d{6}Z\b`), "{{timestamp}}"},
+	// Client tokens and request IDs.
+	{regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`), "{{uuid}}"},
+}
+
+// FactoriesRecorded creates ProviderFactories for the main provider whose API
+// requests are recorded or replayed, as selected by the TF_ACC_RECORD_MODE
+// environment variable. Without it, the provider makes API requests as usual.
+//
+// Recordings are stored per test under testdata/recordings in the package of
+// the test. Only requests made through the returned provider are recorded, so
+// test check functions must call AWS through the providers appended to
+// providers, for example with CheckWithProviders, rather than through Provider.
+func FactoriesRecorded(t *testing.T, providers *[]*schema.Provider) map[string]func() (*schema.Provider, error) {
+	mode := recordMode()
+
+	if mode == "" {
+		return factoriesInit(providers, []string{ProviderName})
+	}
+
+	r, err := newRecorder(mode, recordingPath(t.Name()))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		r.finish(t)
+	})
+
+	p := provider.Provider()
+	r.hook(p)
+
+	if providers != nil {
+		*providers = append(*providers, p)
+	}
+
+	return map[string]func() (*schema.Provider, error){
+		ProviderName: func() (*schema.Provider, error) { return p, nil }, //nolint:unparam
+	}
+}
+
+// recordingPath returns the path of the API request recording of a test.
+func recordingPath(testName string) string {
+	return filepath.Join(recordingsDir, strings.ReplaceAll(testName, "/", "_")+".json")
+}
+
+// recordMode returns whether API requests are recorded or replayed.
+func recordMode() string {
+	return os.Getenv(conns.EnvVarAccRecordMode)
+}
+
+// recorder records the API requests of an acceptance test or replays them.
+// It is safe for concurrent use.
+type recorder struct {
+	mode string
+	path string
+
+	lock       sync.Mutex
+	configured bool
+	names      map[string]string
+	recording  *recording
+	sensitive  map[string]struct{}
+	unmatched  []recordedRequest
+	used       []bool
+}
+
+func newRecorder(mode, path string) (*recorder, error) {
+	if mode != RecordModeRecord && mode != RecordModeReplay {
+		return nil, fmt.Errorf("invalid %s value (%s), expected %s or %s", conns.EnvVarAccRecordMode, mode, RecordModeRecord, RecordModeReplay)
+	}
+
+	return &recorder{
+		mode:      mode,
+		names:     make(map[string]string),
+		path:      path,
+		recording: &recording{},
+		sensitive: make(map[string]struct{}),
+	}, nil
+}
+
+// hook configures the provider to send its API requests through the recorder.
+func (r *recorder) hook(p *schema.Provider) {
+	configure := p.ConfigureFunc
+
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		if r.mode == RecordModeReplay {
+			if err := replayProviderConfig(d); err != nil {
+				return nil, err
+			}
+		}
+
+		meta, err := configure(d)
+
+		if err != nil {
+			return nil, err
+		}
+
+		client, ok := meta.(*conns.AWSClient)
+
+		if !ok {
+			return nil, fmt.Errorf("unexpected provider meta type: %T", meta)
+		}
+
+		if err := r.configure(client); err != nil {
+			return nil, err
+		}
+
+		return client, nil
+	}
+}
+
+// replayProviderConfig overrides the provider configuration so that the
+// provider can be configured without AWS credentials or API requests.
+func replayProviderConfig(d *schema.ResourceData) error {
+	values := map[string]interface{}{
+		"access_key":                    "replay",
+		"allowed_account_ids":           []interface{}{},
+		"assume_role":                   []interface{}{},
+		"assume_role_with_web_identity": []interface{}{},
+		"forbidden_account_ids":         []interface{}{},
+		"profile":                       "",
+		"secret_key":                    "replay",
+		"skip_credentials_validation":   true,
+		"skip_get_ec2_platforms":        true,
+		"skip_metadata_api_check":       true,
+		"skip_requesting_account_id":    true,
+		"token":                         "",
+	}
+
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %s for replay: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// configure sends the API requests of a configured provider through the recorder.
+func (r *recorder) configure(client *conns.AWSClient) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	switch r.mode {
+	case RecordModeRecord:
+		if !r.configured {
+			r.recording.AccountID = client.AccountID
+			r.recording.Partition = client.Partition
+			r.recording.SupportedPlatforms = client.SupportedPlatforms
+		}
+	case RecordModeReplay:
+		if !r.configured {
+			if err := r.load(); err != nil {
+				return err
+			}
+		}
+
+		client.AccountID = r.recording.AccountID
+		client.Partition = r.recording.Partition
+		client.SupportedPlatforms = r.recording.SupportedPlatforms
+	}
+
+	r.configured = true
+
+	client.AddHandlers(r.addHandlers)
+	client.WrapHTTPTransport(func(next http.RoundTripper) http.RoundTripper {
+		return &recorderTransport{
+			next:     next,
+			recorder: r,
+		}
+	})
+
+	return nil
+}
+
+// addHandlers adds request handlers that collect the sensitive values of API
+// requests and responses, as determined by the AWS SDK operation parameters and
+// results, so that they can be redacted from the recording.
+func (r *recorder) addHandlers(handlers *request.Handlers) {
+	// Runs before the request is sent through the recorder's transport.
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "tf.RecorderSensitiveParams",
+		Fn: func(req *request.Request) {
+			r.addSensitiveValues(conns.SensitiveValues(req.Params))
+		},
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tf.RecorderSensitiveData",
+		Fn: func(req *request.Request) {
+			r.addSensitiveValues(conns.SensitiveValues(req.Data))
+		},
+	})
+}
+
+func (r *recorder) addSensitiveValues(values []string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, v := range values {
+		if len(v) >= recordingSensitiveMinLength {
+			r.sensitive[v] = struct{}{}
+		}
+	}
+}
+
+// redact replaces the sensitive values in s, including their escaped forms in
+// URLs, JSON and XML, with a placeholder.
+func (r *recorder) redact(s string) string {
+	var values []string
+
+	for v := range r.sensitive {
+		values = append(values, recordingEscapedValues(v)...)
+	}
+
+	// Longer values first, so that values containing others are fully replaced.
+	sort.Slice(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+
+	for _, v := range values {
+		s = strings.ReplaceAll(s, v, recordingSensitive)
+	}
+
+	return s
+}
+
+func (r *recorder) redactRequest(req recordedRequest) recordedRequest {
+	req.URL = r.redact(req.URL)
+
+	if req.BodyEncoding == "" {
+		req.Body = r.redact(req.Body)
+	}
+
+	return req
+}
+
+func (r *recorder) redactResponse(resp recordedResponse) recordedResponse {
+	for _, values := range resp.Header {
+		for i, v := range values {
+			values[i] = r.redact(v)
+		}
+	}
+
+	if resp.BodyEncoding == "" {
+		if redacted := r.redact(resp.Body); redacted != resp.Body {
+			resp.Body = redacted
+
+			// The body checksum no longer matches.
+			resp.Header.Del("X-Amz-Crc32")
+		}
+	}
+
+	return resp
+}
+
+// recordingEscapedValues returns the forms of a value in API requests and responses.
+func recordingEscapedValues(v string) []string {
+	values := []string{v, url.QueryEscape(v), rest.EscapePath(v, true), rest.EscapePath(v, false)}
+
+	// The AWS SDK does not escape HTML characters in JSON strings.
+	for _, escapeHTML := range []bool{false, true} {
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(escapeHTML)
+
+		if err := encoder.Encode(v); err == nil {
+			values = append(values, strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(buf.String()), `"`), `"`))
+		}
+	}
+
+	var buf bytes.Buffer
+
+	if err := xml.EscapeText(&buf, []byte(v)); err == nil {
+		values = append(values, buf.String())
+	}
+
+	return values
+}
+
+func (r *recorder) load() error {
+	b, err := os.ReadFile(r.path)
+
+	if err != nil {
+		return fmt.Errorf("error reading API request recording (%s), record it with %s=%s: %w", r.path, conns.EnvVarAccRecordMode, RecordModeRecord, err)
+	}
+
+	if err := json.Unmarshal(b, r.recording); err != nil {
+		return fmt.Errorf("error reading API request recording (%s): %w", r.path, err)
+	}
+
+	r.used = make([]bool, len(r.recording.Interactions))
+
+	return nil
+}
+
+func (r *recorder) save() error {
+	for _, v := range r.recording.Interactions {
+		v.Request = r.redactRequest(v.Request)
+		v.Response = r.redactResponse(v.Response)
+	}
+
+	b, err := json.MarshalIndent(r.recording, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(b, '\n'), 0644)
+}
+
+// finish saves the recording of a passed test or reports the requests that
+// did not match the recording.
+func (r *recorder) finish(t *testing.T) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !r.configured {
+		return
+	}
+
+	switch r.mode {
+	case RecordModeRecord:
+		if t.Failed() {
+			t.Logf("not saving API request recording (%s) of failed test", r.path)
+			return
+		}
+
+		if err := r.save(); err != nil {
+			t.Errorf("error saving API request recording (%s): %s", r.path, err)
+		}
+	case RecordModeReplay:
+		if len(r.unmatched) > 0 {
+			t.Errorf("%d API requests did not match the recording (%s):\n\n%s", len(r.unmatched), r.path, r.unmatchedReport())
+		}
+	}
+}
+
+// record appends an API request and its response to the recording.
+func (r *recorder) record(req recordedRequest, resp *http.Response, body []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	recordedResp := recordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+	}
+	recordedResp.Body, recordedResp.BodyEncoding = encodeBody(body)
+
+	r.recording.Interactions = append(r.recording.Interactions, &interaction{
+		Request:  req,
+		Response: recordedResp,
+	})
+}
+
+// replay returns the response of the first unused recorded request that
+// matches req. Random names in the response are replaced with those of the
+// current run.
+func (r *recorder) replay(httpReq *http.Request, req recordedRequest) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// Sensitive values are redacted from the recording.
+	req = r.redactRequest(req)
+	key := normalizeRecordedRequest(req)
+
+	for i, v := range r.recording.Interactions {
+		if r.used[i] || normalizeRecordedRequest(v.Request) != key {
+			continue
+		}
+
+		r.used[i] = true
+		r.learnNames(v.Request, req)
+
+		return r.response(httpReq, v.Response)
+	}
+
+	r.unmatched = append(r.unmatched, req)
+
+	return nil, &unmatchedRequestError{request: req}
+}
+
+// learnNames pairs the random names of a recorded request with those of the
+// matching request of the current run.
+func (r *recorder) learnNames(recorded, current recordedRequest) {
+	recordedNames := recordingRandomNameRegexp.FindAllString(recordedRequestText(recorded), -1)
+	currentNames := recordingRandomNameRegexp.FindAllString(recordedRequestText(current), -1)
+
+	if len(recordedNames) != len(currentNames) {
+		return
+	}
+
+	for i, v := range recordedNames {
+		r.names[v] = currentNames[i]
+	}
+}
+
+func (r *recorder) replaceNames(s string) string {
+	return recordingRandomNameRegexp.ReplaceAllStringFunc(s, func(name string) string {
+		if v, ok := r.names[name]; ok {
+			return v
+		}
+
+		return name
+	})
+}
+
+func (r *recorder) response(httpReq *http.Request, resp recordedResponse) (*http.Response, error) {
+	body, err := decodeBody(resp.Body, resp.BodyEncoding)
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding recorded response body: %w", err)
+	}
+
+	header := http.Header{}
+
+	for k, values := range resp.Header {
+		for _, v := range values {
+			header.Add(k, r.replaceNames(v))
+		}
+	}
+
+	if resp.BodyEncoding == "" {
+		if replaced := r.replaceNames(string(body)); replaced != string(body) {
+			body = []byte(replaced)
+
+			// The body checksum no longer matches.
+			header.Del("X-Amz-Crc32")
+		}
+	}
+
+	header.Del("Content-Length")
+
+	return &http.Response{
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Header:        header,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       httpReq,
+		Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+		StatusCode:    resp.StatusCode,
+	}, nil
+}
+
+// unmatchedReport describes each unmatched request with a diff against the
+// most similar recorded request of the same operation.
+func (r *recorder) unmatchedReport() string {
+	var sb strings.Builder
+
+	for i, req := range r.unmatched {
+		fmt.Fprintf(&sb, "--- request %d: %s %s", i+1, req.Method, req.URL)
+
+		if req.Target != "" {
+			fmt.Fprintf(&sb, " (%s)", req.Target)
+		}
+
+		sb.WriteString("\n")
+
+		current := strings.Split(prettyRecordedRequest(req), "\n")
+
+		var closest []string
+		closestChanges := -1
+
+		for _, v := range r.recording.Interactions {
+			if v.Request.Method != req.Method || v.Request.Target != req.Target || recordedRequestPath(v.Request) != recordedRequestPath(req) {
+				continue
+			}
+
+			recorded := strings.Split(prettyRecordedRequest(v.Request), "\n")
+
+			if changes := len(diffLines(recorded, current)) - len(lcsLines(recorded, current)); closestChanges == -1 || changes < closestChanges {
+				closest = recorded
+				closestChanges = changes
+			}
+		}
+
+		if closest == nil {
+			sb.WriteString("no recorded request of the same operation\n\n")
+			continue
+		}
+
+		sb.WriteString("diff against the most similar recorded request (- recorded, + current):\n")
+
+		for _, line := range diffLines(closest, current) {
+			sb.WriteString(line)
+			sb.WriteString("\n")
+		}
+
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// recorderTransport is an http.RoundTripper that sends requests through a recorder.
+type recorderTransport struct {
+	next     http.RoundTripper
+	recorder *recorder
+}
+
+func (t *recorderTransport) RoundTrip(httpReq *http.Request) (*http.Response, error) {
+	var reqBody []byte
+
+	if httpReq.Body != nil && httpReq.Body != http.NoBody {
+		b, err := io.ReadAll(httpReq.Body)
+		httpReq.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		reqBody = b
+	}
+
+	req := recordedRequest{
+		Method: httpReq.Method,
+		Target: httpReq.Header.Get("X-Amz-Target"),
+		URL:    httpReq.URL.String(),
+	}
+	req.Body, req.BodyEncoding = encodeBody(reqBody)
+
+	if t.recorder.mode == RecordModeReplay {
+		return t.recorder.replay(httpReq, req)
+	}
+
+	next := httpReq.Clone(httpReq.Context())
+
+	if reqBody != nil {
+		next.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.next.RoundTrip(next)
+
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	t.recorder.record(req, resp, respBody)
+
+	return resp, nil
+}
+
+// unmatchedRequestError is returned for a request that matches no recorded request.
+type unmatchedRequestError struct {
+	request recordedRequest
+}
+
+func (e *unmatchedRequestError) Error() string {
+	return fmt.Sprintf("no recorded API request matches %s %s", e.request.Method, e.request.URL)
+}
+
+// Temporary prevents the AWS Go SDK from retrying the request.
+func (e *unmatchedRequestError) Temporary() bool {
+	return false
+}
+
+func encodeBody(b []byte) (string, string) {
+	if utf8.Valid(b) {
+		return string(b), ""
+	}
+
+	return base64.StdEncoding.EncodeToString(b), "base64"
+}
+
+func decodeBody(s, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(s), nil
+	case "base64":
+		return base64.StdEncoding.DecodeString(s)
+	default:
+		return nil, fmt.Errorf("unsupported body encoding: %s", encoding)
+	}
+}
+
+// recordedRequestText returns the parts of a request that are matched.
+func recordedRequestText(req recordedRequest) string {
+	return req.Method + " " + req.URL + "\n" + req.Target + "\n" + req.Body
+}
+
+func recordedRequestPath(req recordedRequest) string {
+	u, err := url.Parse(req.URL)
+
+	if err != nil {
+		return req.URL
+	}
+
+	return normalizeRecordingText(u.Host + u.Path)
+}
+
+func normalizeRecordingText(s string) string {
+	for _, v := range recordingNormalizers {
+		s = v.regexp.ReplaceAllString(s, v.placeholder)
+	}
+
+	return s
+}
+
+func normalizeRecordedRequest(req recordedRequest) string {
+	return normalizeRecordingText(recordedRequestText(req))
+}
+
+// prettyRecordedRequest returns the normalized request with its body split
+// into lines for diffing.
+func prettyRecordedRequest(req recordedRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString(normalizeRecordingText(req.Method + " " + req.URL))
+
+	if req.Target != "" {
+		sb.WriteString("\nX-Amz-Target: " + req.Target)
+	}
+
+	if req.Body == "" {
+		return sb.String()
+	}
+
+	sb.WriteString("\n\n")
+
+	body := normalizeRecordingText(req.Body)
+
+	switch {
+	case req.BodyEncoding != "":
+		sb.WriteString(body)
+	case strings.HasPrefix(body, "{") || strings.HasPrefix(body, "["):
+		var buf bytes.Buffer
+
+		if err := json.Indent(&buf, []byte(body), "", "  "); err != nil {
+			sb.WriteString(body)
+		} else {
+			sb.Write(buf.Bytes())
+		}
+	case strings.HasPrefix(body, "<"):
+		sb.WriteString(strings.ReplaceAll(body, "><", ">\n<"))
+	default:
+		for i, v := range strings.Split(body, "&") {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+
+			if unescaped, err := url.QueryUnescape(v); err == nil {
+				v = unescaped
+			}
+
+			sb.WriteString(v)
+		}
+	}
+
+	return sb.String()
+}
+
+// lcsLines returns the longest common subsequence of two lists of lines.
+func lcsLines(a, b []string) []string {
+	lengths := make([][]int, len(a)+1)
+
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var lcs []string
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			lcs = append(lcs, a[i])
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return lcs
+}
+
+// diffLines returns a line diff of a and b, with removed lines prefixed by
+// "- ", added lines by "+ " and unchanged lines by "  ".
+func diffLines(a, b []string) []string {
+	var diff []string
+	i, j := 0, 0
+
+	for _, common := range lcsLines(a, b) {
+		for ; a[i] != common; i++ {
+			diff = append(diff, "- "+a[i])
+		}
+
+		for ; b[j] != common; j++ {
+			diff = append(diff, "+ "+b[j])
+		}
+
+		diff = append(diff, "  "+common)
+		i++
+		j++
+	}
+
+	for ; i < len(a); i++ {
+		diff = append(diff, "- "+a[i])
+	}
+
+	for ; j < len(b); j++ {
+		diff = append(diff, "+ "+b[j])
+	}
+
+	return diff
+}
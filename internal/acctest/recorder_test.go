@@ -0,0 +1,429 @@
+package acctest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+func testRecorderSession(t *testing.T, r *recorder, endpoint string) *session.Session {
+	t.Helper()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+		Endpoint:    aws.String(endpoint),
+		HTTPClient: &http.Client{
+			Transport: &recorderTransport{
+				next:     http.DefaultTransport,
+				recorder: r,
+			},
+		},
+		MaxRetries: aws.Int(3),
+		Region:     aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	r.addHandlers(&sess.Handlers)
+
+	return sess
+}
+
+func testRecorderSQSConn(t *testing.T, r *recorder, endpoint string) *sqs.SQS {
+	t.Helper()
+
+	return sqs.New(testRecorderSession(t, r, endpoint))
+}
+
+func TestRecorder(t *testing.T) {
+	// A custom CA bundle requires the default HTTP transport.
+	if v, ok := os.LookupEnv(conns.EnvVarCABundle); ok {
+		os.Unsetenv(conns.EnvVarCABundle)
+		defer os.Setenv(conns.EnvVarCABundle, v)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<CreateQueueResponse><CreateQueueResult><QueueUrl>https://sqs.us-west-2.amazonaws.com/123456789012/%s</QueueUrl></CreateQueueResult></CreateQueueResponse>`, r.Form.Get("QueueName")) //lintignore:AWSAT003,AWSAT005
+	}))
+
+	path := filepath.Join(t.TempDir(), "TestRecorder.json")
+	recordedName := "tf-acc-test-1111111111"
+
+	recorder, err := newRecorder(RecordModeRecord, path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	output, err := testRecorderSQSConn(t, recorder, server.URL).CreateQueue(&sqs.CreateQueueInput{
+		QueueName: aws.String(recordedName),
+		Tags:      aws.StringMap(map[string]string{"Created": "2021-11-01T12:00:00Z"}),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := aws.StringValue(output.QueueUrl); !strings.HasSuffix(got, "/"+recordedName) {
+		t.Fatalf("unexpected queue URL: %s", got)
+	}
+
+	if err := recorder.save(); err != nil {
+		t.Fatalf("error saving recording: %s", err)
+	}
+
+	// Replay without the server, with a different random name and timestamp.
+	server.Close()
+
+	replayer, err := newRecorder(RecordModeReplay, path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := replayer.load(); err != nil {
+		t.Fatalf("error loading recording: %s", err)
+	}
+
+	conn := testRecorderSQSConn(t, replayer, server.URL)
+	replayedName := "tf-acc-test-22222222222222"
+
+	output, err = conn.CreateQueue(&sqs.CreateQueueInput{
+		QueueName: aws.String(replayedName),
+		Tags:      aws.StringMap(map[string]string{"Created": "2021-11-18T08:30:00Z"}),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.QueueUrl), "https://sqs.us-west-2.amazonaws.com/123456789012/"+replayedName; got != expected { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("got queue URL %s, expected %s", got, expected)
+	}
+
+	// The recorded request has been used.
+	_, err = conn.CreateQueue(&sqs.CreateQueueInput{
+		QueueName: aws.String(replayedName),
+		Tags:      aws.StringMap(map[string]string{"Created": "2021-11-18T08:30:00Z"}),
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if !strings.Contains(err.Error(), "no recorded API request matches") {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	_, err = conn.CreateQueue(&sqs.CreateQueueInput{
+		QueueName: aws.String(replayedName),
+		Tags:      aws.StringMap(map[string]string{"Created": "2021-11-18T08:30:00Z", "Name": "example"}),
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	// Unmatched requests are not retried.
+	if got, expected := len(replayer.unmatched), 2; got != expected {
+		t.Fatalf("got %d unmatched requests, expected %d", got, expected)
+	}
+
+	report := replayer.unmatchedReport()
+
+	for _, expected := range []string{
+		"--- request 2: POST",
+		"  QueueName={{random}}",
+		"  Tag.1.Value={{timestamp}}",
+		"+ Tag.2.Key=Name",
+		"+ Tag.2.Value=example",
+	} {
+		if !strings.Contains(report, expected) {
+			t.Errorf("expected report to contain %q, got:\n%s", expected, report)
+		}
+	}
+}
+
+func TestRecorderSensitiveValues(t *testing.T) {
+	if v, ok := os.LookupEnv(conns.EnvVarCABundle); ok {
+		os.Unsetenv(conns.EnvVarCABundle)
+		defer os.Setenv(conns.EnvVarCABundle, v)
+	}
+
+	secret := "example-secret\"/+ &<"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "secretsmanager.CreateSecret":
+			fmt.Fprint(w, `{"ARN":"arn:aws:secretsmanager:us-west-2:123456789012:secret:example","Name":"example"}`) //lintignore:AWSAT003,AWSAT005
+		case "secretsmanager.GetSecretValue":
+			b, _ := json.Marshal(secret)
+			fmt.Fprintf(w, `{"Name":"example","SecretString":%s}`, b)
+		}
+	}))
+
+	path := filepath.Join(t.TempDir(), "TestRecorderSensitiveValues.json")
+
+	recorder, err := newRecorder(RecordModeRecord, path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conn := secretsmanager.New(testRecorderSession(t, recorder, server.URL))
+
+	if _, err := conn.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String("example"),
+		SecretString: aws.String(secret),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	output, err := conn.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String("example"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The recording is only redacted when saved.
+	if got := aws.StringValue(output.SecretString); got != secret {
+		t.Errorf("got secret %q, expected %q", got, secret)
+	}
+
+	if err := recorder.save(); err != nil {
+		t.Fatalf("error saving recording: %s", err)
+	}
+
+	server.Close()
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("error reading recording: %s", err)
+	}
+
+	if strings.Contains(string(b), "example-secret") {
+		t.Errorf("expected recording to not contain the secret, got:\n%s", b)
+	}
+
+	if got, expected := strings.Count(string(b), recordingSensitive), 2; got != expected {
+		t.Errorf("got %d redacted values, expected %d:\n%s", got, expected, b)
+	}
+
+	replayer, err := newRecorder(RecordModeReplay, path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := replayer.load(); err != nil {
+		t.Fatalf("error loading recording: %s", err)
+	}
+
+	conn = secretsmanager.New(testRecorderSession(t, replayer, server.URL))
+
+	// The current request is redacted before it is matched.
+	if _, err := conn.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String("example"),
+		SecretString: aws.String(secret),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	output, err = conn.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String("example"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.SecretString), recordingSensitive; got != expected {
+		t.Errorf("got secret %q, expected %q", got, expected)
+	}
+}
+
+func TestRecorderHookReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestRecorderHookReplay.json")
+
+	if err := os.WriteFile(path, []byte(`{"account_id":"123456789012","partition":"aws","supported_platforms":["VPC"],"interactions":[]}`), 0644); err != nil {
+		t.Fatalf("error writing recording: %s", err)
+	}
+
+	r, err := newRecorder(RecordModeReplay, path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	p := provider.Provider()
+	r.hook(p)
+
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"region": "us-west-2", //lintignore:AWSAT003
+	}))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	client := p.Meta().(*conns.AWSClient)
+
+	if got, expected := client.AccountID, "123456789012"; got != expected {
+		t.Errorf("got account ID %s, expected %s", got, expected)
+	}
+
+	if got, expected := client.SupportedPlatforms, []string{"VPC"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got supported platforms %v, expected %v", got, expected)
+	}
+
+	_, err = client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err == nil || !strings.Contains(err.Error(), "no recorded API request matches") {
+		t.Errorf("expected unmatched request error, got: %v", err)
+	}
+}
+
+// TestFactoriesRecorded replays testdata/recordings/TestFactoriesRecorded.json
+// through a provider created by FactoriesRecorded, as an acceptance test would
+// with TF_ACC_RECORD_MODE=replay.
+func TestFactoriesRecorded(t *testing.T) {
+	if v, ok := os.LookupEnv(conns.EnvVarAccRecordMode); ok {
+		defer os.Setenv(conns.EnvVarAccRecordMode, v)
+	} else {
+		defer os.Unsetenv(conns.EnvVarAccRecordMode)
+	}
+
+	os.Setenv(conns.EnvVarAccRecordMode, RecordModeReplay)
+
+	var providers []*schema.Provider
+	factories := FactoriesRecorded(t, &providers)
+
+	p, err := factories[ProviderName]()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(providers) != 1 || providers[0] != p {
+		t.Fatalf("expected the recorded provider to be appended to providers, got %v", providers)
+	}
+
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"region": "us-west-2", //lintignore:AWSAT003
+	}))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	client := p.Meta().(*conns.AWSClient)
+
+	if got, expected := client.AccountID, "123456789012"; got != expected {
+		t.Errorf("got account ID %s, expected %s", got, expected)
+	}
+
+	// The recording was made with a different random name.
+	rName := sdkacctest.RandomWithPrefix(ResourcePrefix)
+
+	output, err := client.SQSConn().GetQueueUrl(&sqs.GetQueueUrlInput{
+		QueueName: aws.String(rName),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.QueueUrl), "https://sqs.us-west-2.amazonaws.com/123456789012/"+rName; got != expected { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("got queue URL %s, expected %s", got, expected)
+	}
+}
+
+func TestNormalizeRecordingText(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "random name",
+			Input:    `{"Name":"tf-acc-test-5577006791947779410"}`,
+			Expected: `{"Name":"{{random}}"}`,
+		},
+		{
+			Name:     "timestamp",
+			Input:    `{"StartTime":"2021-11-01T12:00:00.123Z"}`,
+			Expected: `{"StartTime":"{{timestamp}}"}`,
+		},
+		{
+			Name:     "URL encoded timestamp",
+			Input:    `StartTime=2021-11-01T12%3A00%3A00Z&Version=2016-11-15`,
+			Expected: `StartTime={{timestamp}}&Version=2016-11-15`,
+		},
+		{
+			Name:     "basic timestamp",
+			Input:    `X-Amz-Date=20211101T120000Z`,
+			Expected: `X-Amz-Date={{timestamp}}`,
+		},
+		{
+			Name:     "client token",
+			Input:    `ClientToken=8ad0a5a5-0c1e-4f5e-9b5f-2f6c1b3a7d90`,
+			Expected: `ClientToken={{uuid}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := normalizeRecordingText(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	a := []string{"a", "b", "c", "d"}
+	b := []string{"a", "c", "d", "e"}
+
+	expected := []string{"  a", "- b", "  c", "  d", "+ e"}
+
+	if got := diffLines(a, b); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestRecordingPath(t *testing.T) {
+	if got, expected := recordingPath("TestAccVPC_basic/subtest"), filepath.Join("testdata", "recordings", "TestAccVPC_basic_subtest.json"); got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
@@ -0,0 +1,119 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// AssumeRole is a role assumed using the credentials of the previously assumed
+// role, after the role configured by the AssumeRole* fields of Config.
+type AssumeRole struct {
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	RoleARN           string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// assumeRole returns the role configured by the AssumeRole* fields.
+func (c *Config) assumeRole() AssumeRole {
+	return AssumeRole{
+		DurationSeconds:   c.AssumeRoleDurationSeconds,
+		ExternalID:        c.AssumeRoleExternalID,
+		Policy:            c.AssumeRolePolicy,
+		PolicyARNs:        c.AssumeRolePolicyARNs,
+		RoleARN:           c.AssumeRoleARN,
+		SessionName:       c.AssumeRoleSessionName,
+		Tags:              c.AssumeRoleTags,
+		TransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
+	}
+}
+
+// assumeRoleCredentials returns credentials that assume the role with the
+// session's credentials. The returned credentials call AssumeRole again when
+// they expire.
+func (c *Config) assumeRoleCredentials(sess *session.Session, role AssumeRole) *credentials.Credentials {
+	stsConfig := c.endpointVariantsConfig()
+
+	if v := c.Endpoints[STS]; v != "" {
+		stsConfig.Endpoint = aws.String(v)
+	}
+
+	return stscreds.NewCredentialsWithClient(sts.New(sess, stsConfig), role.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+		if role.DurationSeconds > 0 {
+			p.Duration = time.Duration(role.DurationSeconds) * time.Second
+		}
+
+		if role.ExternalID != "" {
+			p.ExternalID = aws.String(role.ExternalID)
+		}
+
+		if role.Policy != "" {
+			p.Policy = aws.String(role.Policy)
+		}
+
+		for _, policyARN := range role.PolicyARNs {
+			p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+				Arn: aws.String(policyARN),
+			})
+		}
+
+		if role.SessionName != "" {
+			p.RoleSessionName = role.SessionName
+		}
+
+		keys := make([]string, 0, len(role.Tags))
+
+		for k := range role.Tags {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			p.Tags = append(p.Tags, &sts.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(role.Tags[k]),
+			})
+		}
+
+		if len(role.TransitiveTagKeys) > 0 {
+			p.TransitiveTagKeys = aws.StringSlice(role.TransitiveTagKeys)
+		}
+	})
+}
+
+// assumeRoleChain returns a copy of the session with the credentials of the
+// last role in the AssumeRoleChain, each role being assumed with the
+// credentials of the previous one. The session's credentials call AssumeRole
+// again when they expire.
+func (c *Config) assumeRoleChain(sess *session.Session) (*session.Session, error) {
+	for i, role := range c.AssumeRoleChain {
+		if role.RoleARN == "" {
+			return nil, fmt.Errorf("error assuming role %d of assume_role chain: role ARN is required", i+2)
+		}
+
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", role.RoleARN, role.SessionName, role.ExternalID)
+
+		creds := c.assumeRoleCredentials(sess, *role)
+
+		if _, err := creds.Get(); err != nil {
+			return nil, fmt.Errorf("error assuming role (%s): %w", role.RoleARN, err)
+		}
+
+		sess = sess.Copy(&aws.Config{Credentials: creds})
+	}
+
+	return sess, nil
+}
@@ -0,0 +1,308 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+// testSTSRole is a role of the testSTSChainServer.
+type testSTSRole struct {
+	// Access key ID of the credentials returned when assuming the role.
+	AccessKeyID string
+
+	// Access key ID of the credentials that may assume the role.
+	CallerAccessKeyID string
+
+	// Parameters that AssumeRole requests for the role must include.
+	Parameters map[string]string
+}
+
+// testSTSChainServer is an STS API stand-in that only allows each role to be
+// assumed with the credentials of the previous role in a chain.
+type testSTSChainServer struct {
+	*httptest.Server
+
+	lock    sync.Mutex
+	assumed []string
+	errors  []string
+
+	// Roles by ARN.
+	roles map[string]*testSTSRole
+}
+
+var testCredentialAccessKeyIDRegexp = regexp.MustCompile(`Credential=([^/]+)/`)
+
+func newTestSTSChainServer(roles map[string]*testSTSRole) *testSTSChainServer {
+	s := &testSTSChainServer{roles: roles}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+func (s *testSTSChainServer) fail(w http.ResponseWriter, format string, a ...interface{}) {
+	message := fmt.Sprintf(format, a...)
+
+	s.lock.Lock()
+	s.errors = append(s.errors, message)
+	s.lock.Unlock()
+
+	w.WriteHeader(http.StatusForbidden)
+	fmt.Fprintf(w, `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>%s</Message></Error><RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId></ErrorResponse>`, message)
+}
+
+func (s *testSTSChainServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var accessKeyID string
+	if m := testCredentialAccessKeyIDRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		accessKeyID = m[1]
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+
+	switch action := r.Form.Get("Action"); action {
+	case "AssumeRole":
+		roleARN := r.Form.Get("RoleArn")
+		role, ok := s.roles[roleARN]
+
+		if !ok {
+			s.fail(w, "unknown role %s", roleARN)
+			return
+		}
+
+		if accessKeyID != role.CallerAccessKeyID {
+			s.fail(w, "%s may not assume role %s", accessKeyID, roleARN)
+			return
+		}
+
+		for k, expected := range role.Parameters {
+			if got := r.Form.Get(k); got != expected {
+				s.fail(w, "assuming role %s: got %s %q, expected %q", roleARN, k, got, expected)
+				return
+			}
+		}
+
+		s.lock.Lock()
+		s.assumed = append(s.assumed, roleARN)
+		s.lock.Unlock()
+
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>%[1]s/%[2]s</Arn>
+      <AssumedRoleId>AROA%[3]s:%[2]s</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>%[3]s</AccessKeyId>
+      <SecretAccessKey>secretkey</SecretAccessKey>
+      <SessionToken>token</SessionToken>
+      <Expiration>2099-12-31T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`, strings.Replace(strings.Replace(roleARN, ":iam:", ":sts:", 1), ":role/", ":assumed-role/", 1), r.Form.Get("RoleSessionName"), role.AccessKeyID)
+
+	case "GetCallerIdentity":
+		identityARN := "arn:aws:iam::111111111111:user/example" //lintignore:AWSAT005
+
+		for roleARN, role := range s.roles {
+			if role.AccessKeyID == accessKeyID {
+				identityARN = strings.Replace(strings.Replace(roleARN, ":iam:", ":sts:", 1), ":role/", ":assumed-role/", 1) + "/session"
+			}
+		}
+
+		fmt.Fprintf(w, `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>%[1]s</Arn>
+    <UserId>%[2]s</UserId>
+    <Account>%[3]s</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`, identityARN, accessKeyID, strings.Split(identityARN, ":")[4])
+
+	default:
+		s.fail(w, "unexpected action %s", action)
+	}
+}
+
+func TestConfigClientAssumeRoleChain(t *testing.T) {
+	const (
+		hubRoleARN      = "arn:aws:iam::222222222222:role/hub"      //lintignore:AWSAT005
+		workloadRoleARN = "arn:aws:iam::333333333333:role/workload" //lintignore:AWSAT005
+		auditRoleARN    = "arn:aws:iam::444444444444:role/audit"    //lintignore:AWSAT005
+	)
+
+	roles := map[string]*testSTSRole{
+		hubRoleARN: {
+			AccessKeyID:       "ASIAHUB",
+			CallerAccessKeyID: awsbase.MockStaticAccessKey,
+			Parameters: map[string]string{
+				"ExternalId":      "hub-external-id",
+				"RoleSessionName": "hub-session",
+			},
+		},
+		workloadRoleARN: {
+			AccessKeyID:       "ASIAWORKLOAD",
+			CallerAccessKeyID: "ASIAHUB",
+			Parameters: map[string]string{
+				"DurationSeconds":            "1800",
+				"ExternalId":                 "workload-external-id",
+				"RoleSessionName":            "workload-session",
+				"Tags.member.1.Key":          "CostCenter",
+				"Tags.member.1.Value":        "1234",
+				"Tags.member.2.Key":          "Project",
+				"Tags.member.2.Value":        "example",
+				"TransitiveTagKeys.member.1": "Project",
+			},
+		},
+		auditRoleARN: {
+			AccessKeyID:       "ASIAAUDIT",
+			CallerAccessKeyID: "ASIAWORKLOAD",
+			Parameters: map[string]string{
+				"ExternalId": "",
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name              string
+		Chain             []*AssumeRole
+		ExpectedAccountID string
+		ExpectedAssumed   []string
+		ExpectedError     string
+	}{
+		{
+			Name:              "no chain",
+			ExpectedAccountID: "222222222222",
+			ExpectedAssumed:   []string{hubRoleARN},
+		},
+		{
+			Name: "one additional role",
+			Chain: []*AssumeRole{
+				{
+					DurationSeconds:   1800,
+					ExternalID:        "workload-external-id",
+					RoleARN:           workloadRoleARN,
+					SessionName:       "workload-session",
+					Tags:              map[string]string{"Project": "example", "CostCenter": "1234"},
+					TransitiveTagKeys: []string{"Project"},
+				},
+			},
+			ExpectedAccountID: "333333333333",
+			ExpectedAssumed:   []string{hubRoleARN, workloadRoleARN},
+		},
+		{
+			Name: "two additional roles",
+			Chain: []*AssumeRole{
+				{
+					DurationSeconds:   1800,
+					ExternalID:        "workload-external-id",
+					RoleARN:           workloadRoleARN,
+					SessionName:       "workload-session",
+					Tags:              map[string]string{"Project": "example", "CostCenter": "1234"},
+					TransitiveTagKeys: []string{"Project"},
+				},
+				{
+					RoleARN: auditRoleARN,
+				},
+			},
+			ExpectedAccountID: "444444444444",
+			ExpectedAssumed:   []string{hubRoleARN, workloadRoleARN, auditRoleARN},
+		},
+		{
+			Name: "out of order",
+			Chain: []*AssumeRole{
+				{
+					RoleARN: auditRoleARN,
+				},
+			},
+			ExpectedError: "ASIAHUB may not assume role " + auditRoleARN,
+		},
+		{
+			Name: "missing role ARN",
+			Chain: []*AssumeRole{
+				{
+					ExternalID: "workload-external-id",
+				},
+			},
+			ExpectedError: "role 2 of assume_role chain: role ARN is required",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			oldEnv := testUnsetEnv(t)
+			defer testRestoreEnv(t, oldEnv)
+
+			server := newTestSTSChainServer(roles)
+			defer server.Close()
+
+			config := &Config{
+				AccessKey:             awsbase.MockStaticAccessKey,
+				AssumeRoleARN:         hubRoleARN,
+				AssumeRoleChain:       testCase.Chain,
+				AssumeRoleExternalID:  "hub-external-id",
+				AssumeRoleSessionName: "hub-session",
+				Endpoints:             map[string]string{STS: server.URL},
+				Region:                "us-east-1", //lintignore:AWSAT003
+				SecretKey:             awsbase.MockStaticSecretKey,
+				SkipGetEC2Platforms:   true,
+				SkipMetadataApiCheck:  true,
+			}
+
+			raw, err := config.Client()
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s (server errors: %v)", err, server.errors)
+			}
+
+			client := raw.(*AWSClient)
+
+			if got, expected := client.AccountID, testCase.ExpectedAccountID; got != expected {
+				t.Errorf("got account ID %s, expected %s", got, expected)
+			}
+
+			if got, expected := strings.Join(server.assumed, ","), strings.Join(testCase.ExpectedAssumed, ","); got != expected {
+				t.Errorf("got assumed roles %s, expected %s", got, expected)
+			}
+
+			// Service clients use the credentials of the last role.
+			output, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := *output.Account, testCase.ExpectedAccountID; got != expected {
+				t.Errorf("got caller identity account %s, expected %s", got, expected)
+			}
+
+			if len(server.errors) > 0 {
+				t.Errorf("unexpected server errors: %v", server.errors)
+			}
+		})
+	}
+}
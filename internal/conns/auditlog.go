@@ -0,0 +1,283 @@
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// auditLogRedacted replaces the values of sensitive API parameters.
+const auditLogRedacted = "<sensitive>"
+
+// auditLogSensitiveNameFragments are lower case fragments of the names of API
+// parameters whose values are redacted even though the AWS SDK does not mark
+// them as sensitive, for example ElastiCache AuthToken or EC2 UserData.
+var auditLogSensitiveNameFragments = []string{
+	"accesskey",
+	"apikey",
+	"credential",
+	"keymaterial",
+	"passphrase",
+	"password",
+	"privatekey",
+	"secret",
+	"token",
+	"userdata",
+}
+
+// auditLogIdentifierNameSuffixes are lower case suffixes of the names of API
+// parameters that identify, rather than contain, sensitive values, for example
+// Secrets Manager SecretId.
+var auditLogIdentifierNameSuffixes = []string{
+	"arn",
+	"id",
+	"ids",
+	"name",
+}
+
+// auditLogSensitiveName returns whether the value of the API parameter or map
+// key with the specified name is redacted from the API audit log.
+func auditLogSensitiveName(name string) bool {
+	name = strings.ToLower(name)
+
+	for _, suffix := range auditLogIdentifierNameSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+
+	for _, fragment := range auditLogSensitiveNameFragments {
+		if strings.Contains(name, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// auditLogRecord is a line of the API audit log.
+type auditLogRecord struct {
+	Time       time.Time   `json:"time"`
+	Service    string      `json:"service"`
+	Operation  string      `json:"operation"`
+	Region     string      `json:"region"`
+	DurationMS int64       `json:"duration_ms"`
+	RetryCount int         `json:"retry_count"`
+	StatusCode int         `json:"status_code,omitempty"`
+	ErrorCode  string      `json:"error_code,omitempty"`
+	RequestID  string      `json:"request_id,omitempty"`
+	Parameters interface{} `json:"parameters,omitempty"`
+}
+
+// auditLogWriter writes whole lines to an API audit log file, which may be
+// shared by the sessions of several provider configurations.
+type auditLogWriter struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+func (w *auditLogWriter) write(record *auditLogRecord) error {
+	line, err := json.Marshal(record)
+
+	if err != nil {
+		return err
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, err = w.w.Write(append(line, '\n'))
+
+	return err
+}
+
+var (
+	auditLogWriters     = map[string]*auditLogWriter{}
+	auditLogWritersLock sync.Mutex
+)
+
+// openAuditLog returns the writer for the API audit log file at path, opening
+// the file for appending if it is not already open.
+func openAuditLog(path string) (*auditLogWriter, error) {
+	auditLogWritersLock.Lock()
+	defer auditLogWritersLock.Unlock()
+
+	if w, ok := auditLogWriters[path]; ok {
+		return w, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening API audit log: %w", err)
+	}
+
+	w := &auditLogWriter{w: f}
+	auditLogWriters[path] = w
+
+	return w, nil
+}
+
+// configureAPIAuditLog adds a request handler to the session that writes a
+// JSON line describing each API operation to the file at path.
+func configureAPIAuditLog(sess *session.Session, path string) error {
+	w, err := openAuditLog(path)
+
+	if err != nil {
+		return err
+	}
+
+	addAPIAuditLogHandler(sess, w)
+
+	return nil
+}
+
+func addAPIAuditLogHandler(sess *session.Session, w *auditLogWriter) {
+	// Runs once per operation, after any retries.
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tf.APIAuditLogHandler",
+		Fn: func(r *request.Request) {
+			if err := w.write(newAuditLogRecord(r)); err != nil {
+				log.Printf("[WARN] Error writing API audit log: %s", err)
+			}
+		},
+	})
+}
+
+func newAuditLogRecord(r *request.Request) *auditLogRecord {
+	record := &auditLogRecord{
+		Time:       r.Time.UTC(),
+		Service:    r.ClientInfo.ServiceName,
+		Region:     aws.StringValue(r.Config.Region),
+		DurationMS: time.Since(r.Time).Milliseconds(),
+		RetryCount: r.RetryCount,
+		RequestID:  r.RequestID,
+		Parameters: auditLogParameters(reflect.ValueOf(r.Params)),
+	}
+
+	if r.Operation != nil {
+		record.Operation = r.Operation.Name
+	}
+
+	if r.HTTPResponse != nil {
+		record.StatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if awsErr, ok := r.Error.(awserr.Error); ok {
+		record.ErrorCode = awsErr.Code()
+	} else if r.Error != nil {
+		record.ErrorCode = "Unknown"
+	}
+
+	// Some protocols only return the request ID of errors in the response body.
+	if reqErr, ok := r.Error.(awserr.RequestFailure); ok && record.RequestID == "" {
+		record.RequestID = reqErr.RequestID()
+	}
+
+	return record
+}
+
+// auditLogParameters returns API operation parameters in a form suitable for
+// the API audit log, with the values of parameters marked as sensitive by the
+// AWS SDK or with sensitive names redacted and any streamed or binary values
+// replaced by their size.
+func auditLogParameters(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+
+		if _, ok := v.Interface().(io.Reader); ok {
+			return "<stream>"
+		}
+
+		return auditLogParameters(v.Elem())
+
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return t
+		}
+
+		m := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			// Shapes that are sensitive as a whole are marked on their metadata field.
+			if field.Name == "_" && field.Tag.Get("sensitive") == "true" {
+				return auditLogRedacted
+			}
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			fv := v.Field(i)
+
+			if (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface || fv.Kind() == reflect.Slice || fv.Kind() == reflect.Map) && fv.IsNil() {
+				continue
+			}
+
+			if field.Tag.Get("sensitive") == "true" || auditLogSensitiveName(field.Name) {
+				m[field.Name] = auditLogRedacted
+				continue
+			}
+
+			m[field.Name] = auditLogParameters(fv)
+		}
+
+		if len(m) == 0 {
+			return nil
+		}
+
+		return m
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("<%d bytes>", v.Len())
+		}
+
+		l := make([]interface{}, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			l[i] = auditLogParameters(v.Index(i))
+		}
+
+		return l
+
+	case reflect.Map:
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+
+		for iter.Next() {
+			k := fmt.Sprint(iter.Key().Interface())
+
+			if auditLogSensitiveName(k) {
+				m[k] = auditLogRedacted
+				continue
+			}
+
+			m[k] = auditLogParameters(iter.Value())
+		}
+
+		return m
+
+	case reflect.Invalid:
+		return nil
+
+	default:
+		return v.Interface()
+	}
+}
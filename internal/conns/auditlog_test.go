@@ -0,0 +1,320 @@
+package conns
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestAuditLogParameters(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Params   interface{}
+		Expected interface{}
+	}{
+		{
+			Name:     "nil",
+			Params:   (*sts.GetCallerIdentityInput)(nil),
+			Expected: nil,
+		},
+		{
+			Name:     "empty",
+			Params:   &sts.GetCallerIdentityInput{},
+			Expected: nil,
+		},
+		{
+			Name: "sensitive",
+			Params: &iam.CreateLoginProfileInput{
+				Password: aws.String("example-password"),
+				UserName: aws.String("example"),
+			},
+			Expected: map[string]interface{}{
+				"Password": "<sensitive>",
+				"UserName": "example",
+			},
+		},
+		{
+			Name: "sensitive name",
+			Params: &elasticache.CreateReplicationGroupInput{
+				AuthToken:          aws.String("example-token"),
+				ReplicationGroupId: aws.String("example"),
+			},
+			Expected: map[string]interface{}{
+				"AuthToken":          "<sensitive>",
+				"ReplicationGroupId": "example",
+			},
+		},
+		{
+			Name: "user data",
+			Params: &ec2.RunInstancesInput{
+				ImageId:  aws.String("ami-12345678"),
+				UserData: aws.String("IyEvYmluL2Jhc2gK"),
+			},
+			Expected: map[string]interface{}{
+				"ImageId":  "ami-12345678",
+				"UserData": "<sensitive>",
+			},
+		},
+		{
+			Name: "sensitive map key",
+			Params: &ecs.ContainerDefinition{
+				DockerLabels: aws.StringMap(map[string]string{
+					"db_password": "example-password",
+					"team":        "example",
+				}),
+			},
+			Expected: map[string]interface{}{
+				"DockerLabels": map[string]interface{}{
+					"db_password": "<sensitive>",
+					"team":        "example",
+				},
+			},
+		},
+		{
+			Name: "nested",
+			Params: &iam.CreateRoleInput{
+				RoleName: aws.String("example"),
+				Tags: []*iam.Tag{
+					{
+						Key:   aws.String("Name"),
+						Value: aws.String("example"),
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"RoleName": "example",
+				"Tags": []interface{}{
+					map[string]interface{}{
+						"Key":   "Name",
+						"Value": "example",
+					},
+				},
+			},
+		},
+		{
+			Name: "binary",
+			Params: &secretsmanager.PutSecretValueInput{
+				SecretBinary: []byte("example"),
+				SecretId:     aws.String("example"),
+			},
+			Expected: map[string]interface{}{
+				"SecretBinary": "<sensitive>",
+				"SecretId":     "example",
+			},
+		},
+		{
+			Name: "stream",
+			Params: &s3.PutObjectInput{
+				Body:     strings.NewReader("example"),
+				Bucket:   aws.String("example"),
+				Key:      aws.String("example"),
+				Metadata: aws.StringMap(map[string]string{"key": "value"}),
+			},
+			Expected: map[string]interface{}{
+				"Body":     "<stream>",
+				"Bucket":   "example",
+				"Key":      "example",
+				"Metadata": map[string]interface{}{"key": "value"},
+			},
+		},
+		{
+			Name: "bytes",
+			Params: &kinesis.PutRecordInput{
+				Data:         []byte(`{"key":"value"}`),
+				PartitionKey: aws.String("example"),
+				StreamName:   aws.String("example"),
+			},
+			Expected: map[string]interface{}{
+				"Data":         "<15 bytes>",
+				"PartitionKey": "example",
+				"StreamName":   "example",
+			},
+		},
+		{
+			Name: "time",
+			Params: &cloudwatch.GetMetricStatisticsInput{
+				MetricName: aws.String("example"),
+				StartTime:  aws.Time(time.Date(2021, time.November, 1, 12, 0, 0, 0, time.UTC)),
+			},
+			Expected: map[string]interface{}{
+				"MetricName": "example",
+				"StartTime":  time.Date(2021, time.November, 1, 12, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := auditLogParameters(reflect.ValueOf(testCase.Params))
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAuditLogSensitiveName(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Expected bool
+	}{
+		{Name: "AuthToken", Expected: true},
+		{Name: "ClientSecret", Expected: true},
+		{Name: "Credentials", Expected: true},
+		{Name: "MasterUserPassword", Expected: true},
+		{Name: "PrivateKey", Expected: true},
+		{Name: "UserData", Expected: true},
+		{Name: "SecretAccessKey", Expected: true},
+		{Name: "db_password", Expected: true},
+		{Name: "AccessKeyId", Expected: false},
+		{Name: "Key", Expected: false},
+		{Name: "KmsKeyId", Expected: false},
+		{Name: "PartitionKey", Expected: false},
+		{Name: "SecretArn", Expected: false},
+		{Name: "SecretId", Expected: false},
+		{Name: "TokenName", Expected: false},
+		{Name: "UserName", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := auditLogSensitiveName(testCase.Name); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigClientAPIAuditLog(t *testing.T) {
+	oldEnv := testUnsetEnv(t)
+	defer testRestoreEnv(t, oldEnv)
+
+	server := newTestThrottlingServer(2)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "api-audit.log")
+
+	config := &Config{
+		AccessKey:               awsbase.MockStaticAccessKey,
+		APIAuditLog:             path,
+		Endpoints:               map[string]string{IAM: server.URL, STS: server.URL},
+		MaxRetries:              3,
+		Region:                  "us-east-1", //lintignore:AWSAT003
+		SecretKey:               awsbase.MockStaticSecretKey,
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+	client.session.Config.SleepDelay = func(time.Duration) {}
+
+	if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = client.IAMConn().CreateLoginProfile(&iam.CreateLoginProfileInput{
+		Password: aws.String("example-password"),
+		UserName: aws.String("example"),
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("error opening API audit log: %s", err)
+	}
+
+	defer f.Close()
+
+	var records []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		if strings.Contains(scanner.Text(), "example-password") {
+			t.Errorf("expected sensitive parameter to be redacted, got: %s", scanner.Text())
+		}
+
+		var record map[string]interface{}
+
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("error decoding API audit log line %q: %s", scanner.Text(), err)
+		}
+
+		records = append(records, record)
+	}
+
+	if got, expected := len(records), 2; got != expected {
+		t.Fatalf("got %d API audit log records, expected %d", got, expected)
+	}
+
+	for k, expected := range map[string]interface{}{
+		"operation":   "GetCallerIdentity",
+		"region":      "us-east-1", //lintignore:AWSAT003
+		"retry_count": float64(2),
+		"service":     "sts",
+		"status_code": float64(200),
+	} {
+		if got := records[0][k]; got != expected {
+			t.Errorf("got %s %v, expected %v", k, got, expected)
+		}
+	}
+
+	if _, ok := records[0]["error_code"]; ok {
+		t.Errorf("unexpected error_code: %v", records[0]["error_code"])
+	}
+
+	if _, ok := records[0]["duration_ms"]; !ok {
+		t.Error("expected duration_ms")
+	}
+
+	for k, expected := range map[string]interface{}{
+		"error_code":  "InvalidAction",
+		"operation":   "CreateLoginProfile",
+		"request_id":  "01234567-89ab-cdef-0123-456789abcdef",
+		"retry_count": float64(0),
+		"service":     "iam",
+		"status_code": float64(400),
+	} {
+		if got := records[1][k]; got != expected {
+			t.Errorf("got %s %v, expected %v", k, got, expected)
+		}
+	}
+
+	expectedParameters := map[string]interface{}{
+		"Password": "<sensitive>",
+		"UserName": "example",
+	}
+
+	if got := records[1]["parameters"]; !reflect.DeepEqual(got, expectedParameters) {
+		t.Errorf("got parameters %v, expected %v", got, expectedParameters)
+	}
+}
@@ -0,0 +1,422 @@
+package conns
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// s3URICleaningDisabled is the client cache key of the S3 service client
+// with REST protocol URI cleaning disabled.
+const s3URICleaningDisabled = "s3uricleaningdisabled"
+
+// conn returns the service client cached under key, creating it with newConn
+// on first use. Service clients are created from a copy of the provider's
+// session configured for the service. It is safe for concurrent use.
+func (client *AWSClient) conn(key string, newConn func(*session.Session) interface{}) interface{} {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	if conn, ok := client.conns[key]; ok {
+		return conn
+	}
+
+	if client.conns == nil {
+		client.conns = make(map[string]interface{})
+	}
+
+	conn := newConn(client.serviceSession(key))
+	client.conns[key] = conn
+
+	return conn
+}
+
+// regionalConn returns the service client cached under key for the specified
+// region, creating it with newConn on first use. The provider's region uses
+// the same service client as conn. A custom service endpoint is configured for
+// the provider's region, so service clients for other regions use the endpoint
+// resolved by the AWS SDK instead.
+func (client *AWSClient) regionalConn(key, region string, newConn func(*session.Session) interface{}) interface{} {
+	if region == "" || region == client.Region {
+		return client.conn(key, newConn)
+	}
+
+	return client.conn(key+"/"+region, func(*session.Session) interface{} {
+		return newConn(client.serviceSession(key).Copy(&aws.Config{
+			Endpoint: aws.String(""),
+			Region:   aws.String(region),
+		}))
+	})
+}
+
+// ResourceGroupsTaggingAPIConnForRegion returns the Resource Groups Tagging API
+// service client for the specified region.
+func (client *AWSClient) ResourceGroupsTaggingAPIConnForRegion(region string) *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return client.regionalConn(ResourceGroupsTaggingAPI, region, func(sess *session.Session) interface{} {
+		return resourcegroupstaggingapi.New(sess)
+	}).(*resourcegroupstaggingapi.ResourceGroupsTaggingAPI)
+}
+
+// WrapHTTPTransport replaces the HTTP transport of the provider's session with
+// the one returned by wrap, which is passed the current transport. Service
+// clients that were already created are discarded so that all subsequent API
+// requests use the new transport. The acceptance test harness uses it to
+// record and replay API requests.
+func (client *AWSClient) WrapHTTPTransport(wrap func(http.RoundTripper) http.RoundTripper) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	var httpClient http.Client
+	if v := client.session.Config.HTTPClient; v != nil {
+		httpClient = *v
+	}
+
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	httpClient.Transport = wrap(transport)
+
+	client.session = client.session.Copy(&aws.Config{HTTPClient: &httpClient})
+	client.conns = make(map[string]interface{})
+}
+
+// AddHandlers adds request handlers to the provider's session with add, which
+// is passed the session's handlers. Service clients that were already created
+// are discarded so that all subsequent API requests run the new handlers. The
+// acceptance test harness uses it to redact sensitive values from recorded API
+// requests.
+func (client *AWSClient) AddHandlers(add func(*request.Handlers)) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	sess := client.session.Copy()
+	add(&sess.Handlers)
+
+	client.session = sess
+	client.conns = make(map[string]interface{})
+}
+
+// serviceSession returns a copy of the provider's session with any endpoint
+// override and service-specific customizations applied.
+func (client *AWSClient) serviceSession(key string) *session.Session {
+	sess := client.session.Copy(client.serviceConfig(key))
+
+	if h, ok := serviceRetryHandlers[key]; ok {
+		sess.Handlers.Retry.PushBack(h)
+	}
+
+	return sess
+}
+
+// serviceConfig returns the AWS configuration overrides for a service client.
+func (client *AWSClient) serviceConfig(key string) *aws.Config {
+	config := &aws.Config{
+		Endpoint: aws.String(client.endpoints[key]),
+	}
+
+	// Endpoint variants only apply when the endpoint is resolved by the AWS SDK.
+	// An explicitly configured endpoint is used as-is.
+	if client.useDualStackEndpoint {
+		config.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+	}
+
+	if client.useFIPSEndpoint {
+		config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+	}
+
+	switch key {
+	case S3:
+		config.S3ForcePathStyle = aws.Bool(client.s3ForcePathStyle)
+
+		return config
+	case s3URICleaningDisabled:
+		config.DisableRestProtocolURICleaning = aws.Bool(true)
+		config.Endpoint = aws.String(client.endpoints[S3])
+		config.S3ForcePathStyle = aws.Bool(client.s3ForcePathStyle)
+
+		return config
+	}
+
+	// Force "global" services to correct regions
+	switch client.Partition {
+	case endpoints.AwsPartitionID:
+		switch key {
+		case GlobalAccelerator, Route53RecoveryControlConfig, Route53RecoveryReadiness:
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		case Route53, Shield:
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		}
+	case endpoints.AwsCnPartitionID:
+		if key == Route53 {
+			// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+			// This can likely be removed in the future.
+			if aws.StringValue(config.Endpoint) == "" {
+				config.Endpoint = aws.String("https://api.route53.cn")
+			}
+			config.Region = aws.String(endpoints.CnNorthwest1RegionID)
+		}
+	case endpoints.AwsUsGovPartitionID:
+		if key == Route53 {
+			config.Region = aws.String(endpoints.UsGovWest1RegionID)
+		}
+	}
+
+	return config
+}
+
+// serviceRetryHandlers contains additional Retry handlers for service clients
+// that require customizations. They are added to the service client's session
+// when the service client is first created.
+var serviceRetryHandlers = map[string]func(r *request.Request){
+	APIGateway: func(r *request.Request) {
+		// Many operations can return an error such as:
+		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+		// Handle them all globally for the service client.
+		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	AppAutoScaling: func(r *request.Request) {
+		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+			return
+		}
+		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// StartDeployment operations can return a ConflictException
+	// if ongoing deployments are in-progress, thus we handle them
+	// here for the service client.
+	AppConfig: func(r *request.Request) {
+		if r.Operation.Name == "StartDeployment" {
+			if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	AppSync: func(r *request.Request) {
+		if r.Operation.Name == "CreateGraphqlApi" {
+			if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	Chime: func(r *request.Request) {
+		// When calling CreateVoiceConnector across multiple resources,
+		// the API can randomly return a BadRequestException without explanation
+		if r.Operation.Name == "CreateVoiceConnector" {
+			if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	CloudHSMV2: func(r *request.Request) {
+		if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	ConfigService: func(r *request.Request) {
+		// When calling Config Organization Rules API actions immediately
+		// after Organization creation, the API can randomly return the
+		// OrganizationAccessDeniedException error for a few minutes, even
+		// after succeeding a few requests.
+		switch r.Operation.Name {
+		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
+			if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+				return
+			}
+
+			// We only want to retry briefly as the default max retry count would
+			// excessively retry when the error could be legitimate.
+			// We currently depend on the DefaultRetryer exponential backoff here.
+			// ~10 retries gives a fair backoff of a few seconds.
+			if r.RetryCount < 9 {
+				r.Retryable = aws.Bool(true)
+			} else {
+				r.Retryable = aws.Bool(false)
+			}
+		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
+			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
+				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
+					r.Retryable = aws.Bool(true)
+				}
+				return
+			}
+
+			// We only want to retry briefly as the default max retry count would
+			// excessively retry when the error could be legitimate.
+			// We currently depend on the DefaultRetryer exponential backoff here.
+			// ~10 retries gives a fair backoff of a few seconds.
+			if r.RetryCount < 9 {
+				r.Retryable = aws.Bool(true)
+			} else {
+				r.Retryable = aws.Bool(false)
+			}
+		}
+	},
+
+	CloudFormation: func(r *request.Request) {
+		if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	DynamoDB: func(r *request.Request) {
+		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+			return
+		}
+		if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	EC2: func(r *request.Request) {
+		if r.Operation.Name == "CreateClientVpnEndpoint" {
+			if tfawserr.ErrMessageContains(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+
+		if r.Operation.Name == "CreateVpnConnection" {
+			if tfawserr.ErrMessageContains(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+
+		if r.Operation.Name == "CreateVpnGateway" {
+			if tfawserr.ErrMessageContains(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+
+		if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
+			if tfawserr.ErrMessageContains(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	FMS: func(r *request.Request) {
+		// Acceptance testing creates and deletes resources in quick succession.
+		// The FMS onboarding process into Organizations is opaque to consumers.
+		// Since we cannot reasonably check this status before receiving the error,
+		// set the operation as retryable.
+		switch r.Operation.Name {
+		case "AssociateAdminAccount":
+			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
+				r.Retryable = aws.Bool(true)
+			}
+		case "DisassociateAdminAccount":
+			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	Kafka: func(r *request.Request) {
+		if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	Kinesis: func(r *request.Request) {
+		if r.Operation.Name == "CreateStream" {
+			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	Organizations: func(r *request.Request) {
+		// Retry on the following error:
+		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+		if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+	SecurityHub: func(r *request.Request) {
+		switch r.Operation.Name {
+		case "EnableOrganizationAdminAccount":
+			if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+	SSOAdmin: func(r *request.Request) {
+		if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
+			if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+
+	StorageGateway: func(r *request.Request) {
+		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+		if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
+			r.Retryable = aws.Bool(true)
+		}
+	},
+
+	WAFV2: func(r *request.Request) {
+		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
+			r.Retryable = aws.Bool(true)
+		}
+
+		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
+			r.Retryable = aws.Bool(true)
+		}
+
+		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
+			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
+			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+		}
+	},
+}
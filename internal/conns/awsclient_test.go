@@ -0,0 +1,463 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func testAWSClient(tb testing.TB, partition string, region string, endpoints map[string]string) *AWSClient {
+	tb.Helper()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+		Region:      aws.String(region),
+	})
+
+	if err != nil {
+		tb.Fatalf("error creating session: %s", err)
+	}
+
+	return &AWSClient{
+		Partition: partition,
+		Region:    region,
+
+		conns:     make(map[string]interface{}),
+		endpoints: endpoints,
+		session:   sess,
+	}
+}
+
+// testAWSClientConnMethods returns the names of all service client accessor methods.
+func testAWSClientConnMethods() []string {
+	var names []string
+
+	t := reflect.TypeOf(&AWSClient{})
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if (strings.HasSuffix(m.Name, "Conn") || m.Name == "S3ConnURICleaningDisabled") && m.Type.NumIn() == 1 && m.Type.NumOut() == 1 {
+			names = append(names, m.Name)
+		}
+	}
+
+	return names
+}
+
+func TestAWSClientConnLazy(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, map[string]string{
+		EC2: "http://ec2.example.com",
+	})
+
+	if got := len(client.conns); got != 0 {
+		t.Fatalf("expected no service clients before first use, got %d", got)
+	}
+
+	conn := client.EC2Conn()
+
+	if got, expected := conn.Endpoint, "http://ec2.example.com"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+
+	if client.EC2Conn() != conn {
+		t.Error("expected EC2 service client to be memoized")
+	}
+
+	if got, expected := client.IAMConn().Endpoint, "https://iam.amazonaws.com"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+
+	if got := len(client.conns); got != 2 {
+		t.Errorf("expected 2 service clients, got %d", got)
+	}
+}
+
+func TestAWSClientConnConcurrent(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+
+	const n = 50
+	results := make([]interface{}, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = client.S3Conn()
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 1; i < n; i++ {
+		if results[i] != results[0] {
+			t.Fatalf("expected all goroutines to receive the same S3 service client")
+		}
+	}
+}
+
+func TestAWSClientRegionalConn(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+
+	if client.ResourceGroupsTaggingAPIConnForRegion(endpoints.UsWest2RegionID) != client.ResourceGroupsTaggingAPIConn() {
+		t.Error("expected the provider region to use the provider service client")
+	}
+
+	if client.ResourceGroupsTaggingAPIConnForRegion("") != client.ResourceGroupsTaggingAPIConn() {
+		t.Error("expected an empty region to use the provider service client")
+	}
+
+	conn := client.ResourceGroupsTaggingAPIConnForRegion(endpoints.EuWest1RegionID)
+
+	if got, expected := aws.StringValue(conn.Config.Region), endpoints.EuWest1RegionID; got != expected {
+		t.Errorf("got region %s, expected %s", got, expected)
+	}
+
+	if got, expected := conn.Endpoint, "https://tagging.eu-west-1.amazonaws.com"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+
+	if client.ResourceGroupsTaggingAPIConnForRegion(endpoints.EuWest1RegionID) != conn {
+		t.Error("expected regional service client to be memoized")
+	}
+
+	if got := len(client.conns); got != 2 {
+		t.Errorf("expected 2 service clients, got %d", got)
+	}
+}
+
+func TestAWSClientRegionalConnCustomEndpoint(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, map[string]string{
+		ResourceGroupsTaggingAPI: "http://tagging.example.com",
+	})
+
+	if got, expected := client.ResourceGroupsTaggingAPIConnForRegion(endpoints.UsWest2RegionID).Endpoint, "http://tagging.example.com"; got != expected {
+		t.Errorf("got provider region endpoint %s, expected %s", got, expected)
+	}
+
+	if got, expected := client.ResourceGroupsTaggingAPIConnForRegion(endpoints.EuWest1RegionID).Endpoint, "https://tagging.eu-west-1.amazonaws.com"; got != expected {
+		t.Errorf("got other region endpoint %s, expected %s", got, expected)
+	}
+}
+
+type testRoundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f testRoundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestAWSClientWrapHTTPTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, `<GetCallerIdentityResponse><GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`)
+	}))
+	defer server.Close()
+
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, map[string]string{
+		STS: server.URL,
+	})
+
+	conn := client.STSConn()
+
+	var requests int
+	client.WrapHTTPTransport(func(next http.RoundTripper) http.RoundTripper {
+		if next == nil {
+			t.Error("expected the current transport to be passed")
+		}
+
+		return testRoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			requests++
+
+			return next.RoundTrip(r)
+		})
+	})
+
+	if client.STSConn() == conn {
+		t.Error("expected existing service clients to be discarded")
+	}
+
+	output, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.Account), "123456789012"; got != expected {
+		t.Errorf("got account %s, expected %s", got, expected)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected 1 request through the wrapped transport, got %d", requests)
+	}
+}
+
+func TestAWSClientConnGlobalServiceRegion(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Partition      string
+		Region         string
+		Conn           func(*AWSClient) string
+		ExpectedRegion string
+	}{
+		{
+			Name:           "Route 53 AWS Commercial",
+			Partition:      endpoints.AwsPartitionID,
+			Region:         endpoints.UsWest2RegionID,
+			Conn:           func(c *AWSClient) string { return aws.StringValue(c.Route53Conn().Config.Region) },
+			ExpectedRegion: endpoints.UsEast1RegionID,
+		},
+		{
+			Name:           "Route 53 AWS GovCloud (US)",
+			Partition:      endpoints.AwsUsGovPartitionID,
+			Region:         endpoints.UsGovEast1RegionID,
+			Conn:           func(c *AWSClient) string { return aws.StringValue(c.Route53Conn().Config.Region) },
+			ExpectedRegion: endpoints.UsGovWest1RegionID,
+		},
+		{
+			Name:           "Global Accelerator AWS Commercial",
+			Partition:      endpoints.AwsPartitionID,
+			Region:         endpoints.EuWest1RegionID,
+			Conn:           func(c *AWSClient) string { return aws.StringValue(c.GlobalAcceleratorConn().Config.Region) },
+			ExpectedRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name:           "EC2 AWS Commercial",
+			Partition:      endpoints.AwsPartitionID,
+			Region:         endpoints.EuWest1RegionID,
+			Conn:           func(c *AWSClient) string { return aws.StringValue(c.EC2Conn().Config.Region) },
+			ExpectedRegion: endpoints.EuWest1RegionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := testAWSClient(t, testCase.Partition, testCase.Region, nil)
+
+			if got := testCase.Conn(client); got != testCase.ExpectedRegion {
+				t.Errorf("got region %s, expected %s", got, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestAWSClientS3Conns(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, map[string]string{
+		S3: "http://s3.example.com",
+	})
+	client.s3ForcePathStyle = true
+
+	conn := client.S3Conn()
+	uriCleaningDisabledConn := client.S3ConnURICleaningDisabled()
+
+	if conn == uriCleaningDisabledConn {
+		t.Fatal("expected distinct S3 service clients")
+	}
+
+	for _, c := range []*aws.Config{&conn.Config, &uriCleaningDisabledConn.Config} {
+		if got, expected := aws.StringValue(c.Endpoint), "http://s3.example.com"; got != expected {
+			t.Errorf("got endpoint %s, expected %s", got, expected)
+		}
+		if !aws.BoolValue(c.S3ForcePathStyle) {
+			t.Error("expected S3ForcePathStyle")
+		}
+	}
+
+	if aws.BoolValue(conn.Config.DisableRestProtocolURICleaning) {
+		t.Error("expected REST protocol URI cleaning enabled")
+	}
+
+	if !aws.BoolValue(uriCleaningDisabledConn.Config.DisableRestProtocolURICleaning) {
+		t.Error("expected REST protocol URI cleaning disabled")
+	}
+}
+
+func TestAWSClientAllConns(t *testing.T) {
+	client := testAWSClient(t, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+	v := reflect.ValueOf(client)
+
+	for _, name := range testAWSClientConnMethods() {
+		if v.MethodByName(name).Call(nil)[0].IsNil() {
+			t.Errorf("%s returned nil", name)
+		}
+	}
+}
+
+// BenchmarkAWSClientAllConns creates every service client, as was done eagerly
+// when configuring the provider.
+func BenchmarkAWSClientAllConns(b *testing.B) {
+	names := testAWSClientConnMethods()
+	client := testAWSClient(b, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+	v := reflect.ValueOf(client)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		client.conns = make(map[string]interface{})
+
+		for _, name := range names {
+			v.MethodByName(name).Call(nil)
+		}
+	}
+}
+
+// BenchmarkAWSClientFewConns creates only the service clients used by a
+// typical small configuration.
+func BenchmarkAWSClientFewConns(b *testing.B) {
+	client := testAWSClient(b, endpoints.AwsPartitionID, endpoints.UsWest2RegionID, nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		client.conns = make(map[string]interface{})
+
+		client.S3Conn()
+		client.IAMConn()
+	}
+}
+
+func TestAWSClientConnEndpointVariants(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		Partition            string
+		Region               string
+		UseDualStackEndpoint bool
+		UseFIPSEndpoint      bool
+		Endpoints            map[string]string
+		ExpectedHostnames    map[string]string
+	}{
+		{
+			Name:      "AWS Commercial default",
+			Partition: endpoints.AwsPartitionID,
+			Region:    endpoints.UsWest2RegionID,
+			ExpectedHostnames: map[string]string{
+				"EC2Conn":     "ec2.us-west-2.amazonaws.com",
+				"KMSConn":     "kms.us-west-2.amazonaws.com",
+				"Route53Conn": "route53.amazonaws.com",
+				"S3Conn":      "s3.us-west-2.amazonaws.com",
+			},
+		},
+		{
+			Name:            "AWS Commercial FIPS",
+			Partition:       endpoints.AwsPartitionID,
+			Region:          endpoints.UsWest2RegionID,
+			UseFIPSEndpoint: true,
+			ExpectedHostnames: map[string]string{
+				"EC2Conn":     "ec2-fips.us-west-2.amazonaws.com",
+				"KMSConn":     "kms-fips.us-west-2.amazonaws.com",
+				"Route53Conn": "route53-fips.amazonaws.com",
+				"S3Conn":      "s3-fips.us-west-2.amazonaws.com",
+				"STSConn":     "sts-fips.us-west-2.amazonaws.com",
+			},
+		},
+		{
+			Name:                 "AWS Commercial dual-stack",
+			Partition:            endpoints.AwsPartitionID,
+			Region:               endpoints.UsWest2RegionID,
+			UseDualStackEndpoint: true,
+			ExpectedHostnames: map[string]string{
+				"EC2Conn": "api.ec2.us-west-2.aws",
+				"KMSConn": "kms.us-west-2.api.aws",
+				"S3Conn":  "s3.dualstack.us-west-2.amazonaws.com",
+				"STSConn": "sts.us-west-2.api.aws",
+			},
+		},
+		{
+			Name:                 "AWS Commercial FIPS and dual-stack",
+			Partition:            endpoints.AwsPartitionID,
+			Region:               endpoints.UsEast1RegionID,
+			UseDualStackEndpoint: true,
+			UseFIPSEndpoint:      true,
+			ExpectedHostnames: map[string]string{
+				"EC2Conn": "ec2-fips.us-east-1.api.aws",
+				"KMSConn": "kms-fips.us-east-1.api.aws",
+				"S3Conn":  "s3-fips.dualstack.us-east-1.amazonaws.com",
+			},
+		},
+		{
+			Name:            "AWS GovCloud (US) FIPS",
+			Partition:       endpoints.AwsUsGovPartitionID,
+			Region:          endpoints.UsGovWest1RegionID,
+			UseFIPSEndpoint: true,
+			ExpectedHostnames: map[string]string{
+				"EC2Conn":     "ec2-fips.us-gov-west-1.amazonaws.com",
+				"KMSConn":     "kms-fips.us-gov-west-1.amazonaws.com",
+				"LambdaConn":  "lambda-fips.us-gov-west-1.amazonaws.com",
+				"Route53Conn": "route53.us-gov.amazonaws.com",
+				"S3Conn":      "s3-fips.us-gov-west-1.amazonaws.com",
+			},
+		},
+		{
+			Name:                 "AWS GovCloud (US) dual-stack",
+			Partition:            endpoints.AwsUsGovPartitionID,
+			Region:               endpoints.UsGovWest1RegionID,
+			UseDualStackEndpoint: true,
+			ExpectedHostnames: map[string]string{
+				"EC2Conn": "ec2.us-gov-west-1.api.aws",
+				"S3Conn":  "s3.dualstack.us-gov-west-1.amazonaws.com",
+			},
+		},
+		{
+			Name:                 "AWS China dual-stack",
+			Partition:            endpoints.AwsCnPartitionID,
+			Region:               endpoints.CnNorth1RegionID,
+			UseDualStackEndpoint: true,
+			ExpectedHostnames: map[string]string{
+				"EC2Conn":     "ec2.cn-north-1.api.amazonwebservices.com.cn",
+				"Route53Conn": "api.route53.cn",
+				"S3Conn":      "s3.dualstack.cn-north-1.amazonaws.com.cn",
+			},
+		},
+		{
+			Name:                 "explicit endpoints",
+			Partition:            endpoints.AwsPartitionID,
+			Region:               endpoints.UsWest2RegionID,
+			UseDualStackEndpoint: true,
+			UseFIPSEndpoint:      true,
+			Endpoints: map[string]string{
+				EC2: "https://ec2.example.com",
+				S3:  "https://s3.example.com",
+			},
+			ExpectedHostnames: map[string]string{
+				"EC2Conn":                   "ec2.example.com",
+				"KMSConn":                   "kms-fips.us-west-2.api.aws",
+				"S3Conn":                    "s3.example.com",
+				"S3ConnURICleaningDisabled": "s3.example.com",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := testAWSClient(t, testCase.Partition, testCase.Region, testCase.Endpoints)
+			client.useDualStackEndpoint = testCase.UseDualStackEndpoint
+			client.useFIPSEndpoint = testCase.UseFIPSEndpoint
+			v := reflect.ValueOf(client)
+
+			for name, expected := range testCase.ExpectedHostnames {
+				conn := v.MethodByName(name).Call(nil)[0].Elem()
+				endpoint := conn.FieldByName("Client").Elem().FieldByName("Endpoint").String()
+
+				u, err := url.Parse(endpoint)
+
+				if err != nil {
+					t.Fatalf("%s: error parsing endpoint (%s): %s", name, endpoint, err)
+				}
+
+				if got := u.Hostname(); got != expected {
+					t.Errorf("%s: got hostname %s, expected %s", name, got, expected)
+				}
+			}
+		})
+	}
+}
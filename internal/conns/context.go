@@ -0,0 +1,25 @@
+package conns
+
+import (
+	"context"
+)
+
+type contextKey int
+
+const (
+	resourceTypeContextKey contextKey = iota
+)
+
+// NewResourceTypeContext returns a copy of ctx that carries the Terraform
+// resource type name, e.g. aws_instance.
+func NewResourceTypeContext(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeContextKey, resourceType)
+}
+
+// ResourceTypeFromContext returns the Terraform resource type name carried by
+// ctx, if any.
+func ResourceTypeFromContext(ctx context.Context) (string, bool) {
+	resourceType, ok := ctx.Value(resourceTypeContextKey).(string)
+
+	return resourceType, ok
+}
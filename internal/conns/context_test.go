@@ -0,0 +1,18 @@
+package conns
+
+import (
+	"context"
+	"testing"
+)
+
+func TestResourceTypeContext(t *testing.T) {
+	if _, ok := ResourceTypeFromContext(context.Background()); ok {
+		t.Error("expected no resource type")
+	}
+
+	ctx := NewResourceTypeContext(context.Background(), "aws_vpc")
+
+	if got, ok := ResourceTypeFromContext(ctx); !ok || got != "aws_vpc" {
+		t.Errorf("got resource type %q (%t), expected aws_vpc", got, ok)
+	}
+}
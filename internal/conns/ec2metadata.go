@@ -0,0 +1,13 @@
+package conns
+
+const (
+	EC2MetadataServiceEndpointModeIPv4 = "IPv4"
+	EC2MetadataServiceEndpointModeIPv6 = "IPv6"
+)
+
+func EC2MetadataServiceEndpointMode_Values() []string {
+	return []string{
+		EC2MetadataServiceEndpointModeIPv4,
+		EC2MetadataServiceEndpointModeIPv6,
+	}
+}
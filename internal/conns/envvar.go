@@ -29,6 +29,20 @@ const (
 	EnvVarSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
 )
 
+// AWS Go SDK environment variables used as defaults for provider arguments that
+// aws-sdk-go-base does not support directly.
+// These are not provided as constants in the AWS Go SDK currently.
+const (
+	// Path to a file of PEM encoded certificates to trust in addition to the system roots
+	EnvVarCABundle = "AWS_CA_BUNDLE"
+
+	// Address of the EC2 Instance Metadata Service (IMDS) endpoint
+	EnvVarEC2MetadataServiceEndpoint = "AWS_EC2_METADATA_SERVICE_ENDPOINT"
+
+	// Addressing mode (IPv4 or IPv6) of the default EC2 Instance Metadata Service (IMDS) endpoint
+	EnvVarEC2MetadataServiceEndpointMode = "AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE"
+)
+
 // Custom environment variables used in the Terraform AWS Provider testing.
 // Additions should also be documented in the Environment Variable Dictionary
 // of the Maintainers Guide: docs/MAINTAINING.md
@@ -54,6 +68,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For acceptance tests using recorded provider factories, either "record" to
+	// record API requests to fixture files or "replay" to replay them
+	EnvVarAccRecordMode = "TF_ACC_RECORD_MODE"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -72,6 +90,24 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to configure resource sweepers
+const (
+	// The maximum number of resources deleted concurrently by each sweeper
+	EnvVarSweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// Log the resources that would be deleted without deleting them
+	EnvVarSweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// The minimum age of resources to sweep, e.g. 24h
+	EnvVarSweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Comma-separated name prefixes of resources to sweep
+	EnvVarSweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// A tag, as key or key=value, that resources must have to be swept
+	EnvVarSweepTag = "TF_AWS_SWEEP_TAG"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
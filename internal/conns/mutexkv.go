@@ -1,48 +1,252 @@
 package conns
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"runtime"
 	"sync"
+	"time"
 )
 
+// mutexKVLongHeldThreshold is how long a lock may be held before it is
+// reported, both when released and by callers waiting for it.
+var mutexKVLongHeldThreshold = 5 * time.Minute
+
 // MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+//
+// Each key can be held either by a single writer (Lock) or by any number of
+// readers (RLock). Waiting writers take priority over new readers.
 type MutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyLock
+}
+
+// keyLock is the lock state of a single key, guarded by MutexKV.lock.
+type keyLock struct {
+	writer         bool
+	readers        int
+	waitingWriters int
+
+	// Description of the writer and the time since which the lock has been held,
+	// for diagnostics. Readers are not tracked individually, so for readers this
+	// is the time since which the lock has been continuously read locked.
+	holder     string
+	acquiredAt time.Time
+
+	// released is closed, and replaced, whenever the lock is released.
+	released chan struct{}
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *MutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	// Never fails as the background context is never done.
+	_ = m.acquire(context.Background(), key, true)
+}
+
+// LockContext locks the mutex for the given key, waiting until ctx is done.
+// The context of a CRUD function is done when the resource's timeout expires.
+// On success the caller is responsible for calling Unlock for the same key.
+func (m *MutexKV) LockContext(ctx context.Context, key string) error {
+	return m.acquire(ctx, key, true)
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *MutexKV) Unlock(key string) {
-	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
-	log.Printf("[DEBUG] Unlocked %q", key)
+	m.release(key, true)
+}
+
+// RLock locks the mutex for the given key for reading, allowing other readers
+// but not writers. Caller is responsible for calling RUnlock for the same key
+func (m *MutexKV) RLock(key string) {
+	// Never fails as the background context is never done.
+	_ = m.acquire(context.Background(), key, false)
+}
+
+// RLockContext locks the mutex for the given key for reading, waiting until
+// ctx is done. On success the caller is responsible for calling RUnlock for
+// the same key.
+func (m *MutexKV) RLockContext(ctx context.Context, key string) error {
+	return m.acquire(ctx, key, false)
+}
+
+// RUnlock releases a read lock for the given key. Caller must have called RLock for the same key first
+func (m *MutexKV) RUnlock(key string) {
+	m.release(key, false)
+}
+
+func (m *MutexKV) acquire(ctx context.Context, key string, write bool) error {
+	holder := lockHolder(ctx)
+
+	log.Printf("[DEBUG] Locking %q (%s)", key, holder)
+
+	m.lock.Lock()
+	l := m.get(key)
+
+	if write {
+		l.waitingWriters++
+	}
+
+	ticker := time.NewTicker(mutexKVLongHeldThreshold)
+	defer ticker.Stop()
+
+	for {
+		if write && !l.writer && l.readers == 0 {
+			l.waitingWriters--
+			l.writer = true
+			l.holder = holder
+			l.acquiredAt = time.Now()
+			m.lock.Unlock()
+
+			log.Printf("[DEBUG] Locked %q", key)
+
+			return nil
+		}
+
+		if !write && !l.writer && l.waitingWriters == 0 {
+			if l.readers == 0 {
+				l.acquiredAt = time.Now()
+			}
+
+			l.readers++
+			m.lock.Unlock()
+
+			log.Printf("[DEBUG] Read locked %q", key)
+
+			return nil
+		}
+
+		released := l.released
+		m.lock.Unlock()
+
+		select {
+		case <-released:
+		case <-ticker.C:
+			m.lock.Lock()
+			log.Printf("[WARN] Waiting for lock %q held by %s for %s", key, l.holders(), time.Since(l.acquiredAt).Round(time.Second))
+			m.lock.Unlock()
+		case <-ctx.Done():
+			m.lock.Lock()
+			err := fmt.Errorf("error waiting for lock %q held by %s for %s: %w", key, l.holders(), time.Since(l.acquiredAt).Round(time.Millisecond), ctx.Err())
+
+			if write {
+				l.waitingWriters--
+
+				// Readers may have been waiting behind this writer.
+				l.broadcast()
+			}
+
+			m.lock.Unlock()
+
+			return err
+		}
+
+		m.lock.Lock()
+	}
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
+func (m *MutexKV) release(key string, write bool) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+
 	m.lock.Lock()
-	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+	l := m.get(key)
+
+	if write {
+		if !l.writer {
+			m.lock.Unlock()
+			panic(fmt.Sprintf("unlock of unlocked key %q", key))
+		}
+
+		l.writer = false
+	} else {
+		if l.readers == 0 {
+			m.lock.Unlock()
+			panic(fmt.Sprintf("read unlock of unlocked key %q", key))
+		}
+
+		l.readers--
+	}
+
+	if l.readers > 0 {
+		readers := l.readers
+		m.lock.Unlock()
+
+		log.Printf("[DEBUG] Read unlocked %q (readers remaining: %d)", key, readers)
+
+		return
+	}
+
+	heldFor := time.Since(l.acquiredAt)
+	holder := l.holder
+
+	if !write {
+		holder = "readers"
+	}
+
+	l.holder = ""
+	l.broadcast()
+
+	m.lock.Unlock()
+
+	if heldFor >= mutexKVLongHeldThreshold {
+		log.Printf("[WARN] Unlocked %q held by %s for %s", key, holder, heldFor.Round(time.Second))
+	} else {
+		log.Printf("[DEBUG] Unlocked %q held for %s", key, heldFor)
+	}
+}
+
+// holders describes the current holders of the lock. Caller must hold
+// MutexKV.lock
+func (l *keyLock) holders() string {
+	switch {
+	case l.writer:
+		return l.holder
+	case l.readers == 1:
+		return "1 reader"
+	default:
+		return fmt.Sprintf("%d readers", l.readers)
+	}
+}
+
+// broadcast wakes all callers waiting for the lock.
+func (l *keyLock) broadcast() {
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// Returns the lock state for the given key. Caller must hold m.lock
+func (m *MutexKV) get(key string) *keyLock {
+	l, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		l = &keyLock{released: make(chan struct{})}
+		m.store[key] = l
+	}
+	return l
+}
+
+// lockHolder describes the caller acquiring a lock, using the resource type
+// carried by ctx if any and the caller's location.
+func lockHolder(ctx context.Context) string {
+	holder := "unknown"
+
+	// Skip lockHolder, acquire and the exported locking method.
+	if _, file, line, ok := runtime.Caller(3); ok {
+		holder = fmt.Sprintf("%s:%d", file, line)
+	}
+
+	if resourceType, ok := ResourceTypeFromContext(ctx); ok {
+		holder = fmt.Sprintf("%s (%s)", resourceType, holder)
 	}
-	return mutex
+
+	return holder
 }
 
 // Returns a properly initialized MutexKV
 func NewMutexKV() *MutexKV {
 	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyLock),
 	}
 }
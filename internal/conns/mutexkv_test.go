@@ -1,6 +1,14 @@
 package conns
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -65,3 +73,242 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockContextCancel(t *testing.T) {
+	mkv := NewMutexKV()
+
+	if err := mkv.LockContext(NewResourceTypeContext(context.Background(), "aws_security_group_rule"), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := mkv.LockContext(ctx, "foo")
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	for _, expected := range []string{`"foo"`, "aws_security_group_rule", "mutexkv_test.go"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got: %s", expected, err)
+		}
+	}
+
+	mkv.Unlock("foo")
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockContext(ctx, "foo"); err != nil {
+		t.Fatalf("Lock blocked after unlock following a cancelled lock: %s", err)
+	}
+}
+
+func TestMutexKVLockContextContention(t *testing.T) {
+	mkv := NewMutexKV()
+
+	var active, maxActive, count int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+
+			if n := atomic.AddInt32(&active, 1); n > atomic.LoadInt32(&maxActive) {
+				atomic.StoreInt32(&maxActive, n)
+			}
+
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&count, 1)
+			atomic.AddInt32(&active, -1)
+
+			mkv.Unlock("foo")
+		}()
+	}
+
+	wg.Wait()
+
+	if got, expected := count, int32(20); got != expected {
+		t.Errorf("got %d locks, expected %d", got, expected)
+	}
+
+	if maxActive != 1 {
+		t.Errorf("got %d concurrent lock holders, expected 1", maxActive)
+	}
+}
+
+func TestMutexKVRLock(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.RLock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.RLockContext(ctx, "foo"); err != nil {
+		t.Fatalf("Second read lock blocked. This shouldn't happen: %s", err)
+	}
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Lock was able to be taken while read locked. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+
+	mkv.RUnlock("foo")
+	mkv.RUnlock("foo")
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Lock blocked after read unlocks. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVRLockWaitingWriter(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.RLock("foo")
+
+	writerCtx, cancelWriter := context.WithCancel(context.Background())
+	writerErrCh := make(chan error)
+
+	go func() {
+		writerErrCh <- mkv.LockContext(writerCtx, "foo")
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.RLockContext(ctx, "foo"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected read lock to wait behind a waiting writer, got: %v", err)
+	}
+
+	cancelWriter()
+
+	if err := <-writerErrCh; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.RLockContext(ctx, "foo"); err != nil {
+		t.Fatalf("Read lock blocked after waiting writer was cancelled: %s", err)
+	}
+}
+
+func TestMutexKVLongHeld(t *testing.T) {
+	oldThreshold := mutexKVLongHeldThreshold
+	mutexKVLongHeldThreshold = 10 * time.Millisecond
+	defer func() { mutexKVLongHeldThreshold = oldThreshold }()
+
+	var buf bytes.Buffer
+	var bufLock sync.Mutex
+	log.SetOutput(&testSyncWriter{lock: &bufLock, w: &buf})
+	defer log.SetOutput(os.Stderr)
+
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		mkv.Unlock("foo")
+		close(doneCh)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	mkv.Unlock("foo")
+	<-doneCh
+
+	bufLock.Lock()
+	output := buf.String()
+	bufLock.Unlock()
+
+	for _, expected := range []string{`[WARN] Waiting for lock "foo" held by`, `[WARN] Unlocked "foo" held by`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected log to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
+
+func TestMutexKVLongHeldReaders(t *testing.T) {
+	oldThreshold := mutexKVLongHeldThreshold
+	mutexKVLongHeldThreshold = 10 * time.Millisecond
+	defer func() { mutexKVLongHeldThreshold = oldThreshold }()
+
+	var buf bytes.Buffer
+	var bufLock sync.Mutex
+	log.SetOutput(&testSyncWriter{lock: &bufLock, w: &buf})
+	defer log.SetOutput(os.Stderr)
+
+	mkv := NewMutexKV()
+
+	mkv.RLock("foo")
+	mkv.RLock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := mkv.LockContext(ctx, "foo")
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+
+	if expected := `held by 2 readers`; !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error to contain %q, got: %s", expected, err)
+	}
+
+	mkv.RUnlock("foo")
+	mkv.RUnlock("foo")
+
+	bufLock.Lock()
+	output := buf.String()
+	bufLock.Unlock()
+
+	for _, expected := range []string{
+		`[WARN] Waiting for lock "foo" held by 2 readers`,
+		`[DEBUG] Read unlocked "foo" (readers remaining: 1)`,
+		`[WARN] Unlocked "foo" held by readers`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected log to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
+
+type testSyncWriter struct {
+	lock *sync.Mutex
+	w    *bytes.Buffer
+}
+
+func (w *testSyncWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.w.Write(p)
+}
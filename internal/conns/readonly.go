@@ -0,0 +1,93 @@
+package conns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// ErrCodeReadOnlyOperation is the error code of requests rejected because
+// the provider is configured as read-only.
+const ErrCodeReadOnlyOperation = "ReadOnlyOperationNotAllowed"
+
+// readOnlyOperationPrefixes are the prefixes of API operation names that do not
+// modify resources.
+var readOnlyOperationPrefixes = []string{
+	"Describe",
+	"Get",
+	"List",
+}
+
+// readOnlyAllowedOperations are API operations, in <service>:<operation> form,
+// that do not modify resources despite their names.
+var readOnlyAllowedOperations = []string{
+	"dynamodb:BatchGetItem",
+	"dynamodb:Query",
+	"dynamodb:Scan",
+	"ec2:SearchLocalGatewayRoutes",
+	"ec2:SearchTransitGatewayMulticastGroups",
+	"ec2:SearchTransitGatewayRoutes",
+	"s3:HeadBucket",
+	"s3:HeadObject",
+	"sts:AssumeRole",
+	"sts:AssumeRoleWithSAML",
+	"sts:AssumeRoleWithWebIdentity",
+	"sts:DecodeAuthorizationMessage",
+}
+
+// configureReadOnly adds a request handler to the session that rejects API
+// operations that may modify resources. Operations named in allowedOperations,
+// in <service>:<operation> form, are allowed in addition to the default ones.
+func configureReadOnly(sess *session.Session, allowedOperations []string) {
+	allowed := make(map[string]bool)
+
+	for _, operation := range readOnlyAllowedOperations {
+		allowed[strings.ToLower(operation)] = true
+	}
+
+	for _, operation := range allowedOperations {
+		allowed[strings.ToLower(operation)] = true
+	}
+
+	// Runs before the request is built and signed, so rejected requests are
+	// never sent or retried.
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "tf.ReadOnlyHandler",
+		Fn: func(r *request.Request) {
+			if r.Operation == nil || isReadOnlyOperation(r, allowed) {
+				return
+			}
+
+			r.Error = awserr.New(ErrCodeReadOnlyOperation, fmt.Sprintf("%s:%s may modify resources and the provider is configured with read_only", r.ClientInfo.ServiceName, r.Operation.Name), nil)
+		},
+	})
+}
+
+func isReadOnlyOperation(r *request.Request, allowed map[string]bool) bool {
+	name := r.Operation.Name
+
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	// IAM action prefixes mostly match the service name of the API client, but
+	// some match its service ID instead, e.g. cloudwatch rather than monitoring.
+	services := []string{
+		r.ClientInfo.ServiceName,
+		r.ClientInfo.SigningName,
+		strings.ReplaceAll(r.ClientInfo.ServiceID, " ", ""),
+	}
+
+	for _, service := range services {
+		if service != "" && allowed[strings.ToLower(service+":"+name)] {
+			return true
+		}
+	}
+
+	return false
+}
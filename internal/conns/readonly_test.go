@@ -0,0 +1,322 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iotdataplane"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+func TestConfigureReadOnly(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		AllowedOperations []string
+		Request           func(sess *session.Session) *request.Request
+		ExpectedError     bool
+	}{
+		{
+			Name: "describe",
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := ec2.New(sess).DescribeVpcsRequest(&ec2.DescribeVpcsInput{})
+				return req
+			},
+		},
+		{
+			Name: "get",
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := sts.New(sess).GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+				return req
+			},
+		},
+		{
+			Name: "list",
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := iam.New(sess).ListRolesRequest(&iam.ListRolesInput{})
+				return req
+			},
+		},
+		{
+			Name: "create",
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := ec2.New(sess).CreateVpcRequest(&ec2.CreateVpcInput{})
+				return req
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "delete",
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := iam.New(sess).DeleteRoleRequest(&iam.DeleteRoleInput{})
+				return req
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "default allowed",
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := sts.New(sess).AssumeRoleRequest(&sts.AssumeRoleInput{
+					RoleArn:         aws.String("arn:aws:iam::123456789012:role/example"), //lintignore:AWSAT005
+					RoleSessionName: aws.String("example"),
+				})
+				return req
+			},
+		},
+		{
+			Name: "default allowed head",
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := s3.New(sess).HeadBucketRequest(&s3.HeadBucketInput{
+					Bucket: aws.String("example"),
+				})
+				return req
+			},
+		},
+		{
+			Name: "not allowed",
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := s3.New(sess).PutObjectRequest(&s3.PutObjectInput{
+					Bucket: aws.String("example"),
+					Key:    aws.String("example"),
+				})
+				return req
+			},
+			ExpectedError: true,
+		},
+		{
+			Name:              "allowed by service name",
+			AllowedOperations: []string{"iam:SimulatePrincipalPolicy"},
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := iam.New(sess).SimulatePrincipalPolicyRequest(&iam.SimulatePrincipalPolicyInput{
+					ActionNames:     aws.StringSlice([]string{"s3:GetObject"}),
+					PolicySourceArn: aws.String("arn:aws:iam::123456789012:role/example"), //lintignore:AWSAT005
+				})
+				return req
+			},
+		},
+		{
+			Name:              "allowed by service ID",
+			AllowedOperations: []string{"CloudWatch:PutMetricData"},
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := cloudwatch.New(sess).PutMetricDataRequest(&cloudwatch.PutMetricDataInput{
+					MetricData: []*cloudwatch.MetricDatum{{MetricName: aws.String("example")}},
+					Namespace:  aws.String("example"),
+				})
+				return req
+			},
+		},
+		{
+			Name:              "allowed by service name differing from service ID",
+			AllowedOperations: []string{"monitoring:PutMetricData"},
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := cloudwatch.New(sess).PutMetricDataRequest(&cloudwatch.PutMetricDataInput{
+					MetricData: []*cloudwatch.MetricDatum{{MetricName: aws.String("example")}},
+					Namespace:  aws.String("example"),
+				})
+				return req
+			},
+		},
+		{
+			Name:              "allowed by service name differing from signing name",
+			AllowedOperations: []string{"data.iot:Publish"},
+			Request:           testReadOnlyIoTDataPlanePublishRequest,
+		},
+		{
+			Name:              "allowed by signing name",
+			AllowedOperations: []string{"iotdata:Publish"},
+			Request:           testReadOnlyIoTDataPlanePublishRequest,
+		},
+		{
+			Name:              "allowed by service ID without spaces",
+			AllowedOperations: []string{"IoTDataPlane:Publish"},
+			Request:           testReadOnlyIoTDataPlanePublishRequest,
+		},
+		{
+			Name:              "allowed by service ID with spaces",
+			AllowedOperations: []string{"IoT Data Plane:Publish"},
+			Request:           testReadOnlyIoTDataPlanePublishRequest,
+			ExpectedError:     true,
+		},
+		{
+			Name:              "allowed case-insensitively",
+			AllowedOperations: []string{"IAM:simulateprincipalPOLICY"},
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := iam.New(sess).SimulatePrincipalPolicyRequest(&iam.SimulatePrincipalPolicyInput{
+					ActionNames:     aws.StringSlice([]string{"s3:GetObject"}),
+					PolicySourceArn: aws.String("arn:aws:iam::123456789012:role/example"), //lintignore:AWSAT005
+				})
+				return req
+			},
+		},
+		{
+			Name:              "allowed by service ID case-insensitively",
+			AllowedOperations: []string{"iotdataplane:PUBLISH"},
+			Request:           testReadOnlyIoTDataPlanePublishRequest,
+		},
+		{
+			Name:              "allowed by other service",
+			AllowedOperations: []string{"ec2:PutMetricData"},
+			Request: func(sess *session.Session) *request.Request {
+				req, _ := cloudwatch.New(sess).PutMetricDataRequest(&cloudwatch.PutMetricDataInput{
+					MetricData: []*cloudwatch.MetricDatum{{MetricName: aws.String("example")}},
+					Namespace:  aws.String("example"),
+				})
+				return req
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sess, err := session.NewSession(&aws.Config{
+				Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+				Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+			})
+
+			if err != nil {
+				t.Fatalf("error creating session: %s", err)
+			}
+
+			configureReadOnly(sess, testCase.AllowedOperations)
+
+			// Building the request runs the validate and build handlers without
+			// sending it.
+			err = testCase.Request(sess).Build()
+
+			if testCase.ExpectedError {
+				if !tfawserr.ErrCodeEquals(err, ErrCodeReadOnlyOperation) {
+					t.Fatalf("expected %s error, got: %v", ErrCodeReadOnlyOperation, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+// testReadOnlyIoTDataPlanePublishRequest returns a request of a client whose
+// service name (data.iot), signing name (iotdata) and service ID (IoT Data
+// Plane) all differ.
+func testReadOnlyIoTDataPlanePublishRequest(sess *session.Session) *request.Request {
+	conn := iotdataplane.New(sess, &aws.Config{
+		Endpoint: aws.String("https://data.iot.us-west-2.amazonaws.com"), //lintignore:AWSAT003
+	})
+
+	req, _ := conn.PublishRequest(&iotdataplane.PublishInput{
+		Topic: aws.String("example"),
+	})
+
+	return req
+}
+
+func TestConfigClientReadOnly(t *testing.T) {
+	oldEnv := testUnsetEnv(t)
+	defer testRestoreEnv(t, oldEnv)
+
+	config := &Config{
+		AccessKey:               awsbase.MockStaticAccessKey,
+		ReadOnly:                true,
+		Region:                  "us-east-1", //lintignore:AWSAT003
+		SecretKey:               awsbase.MockStaticSecretKey,
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	req, _ := client.EC2Conn().DescribeVpcsRequest(&ec2.DescribeVpcsInput{})
+
+	if err := req.Build(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	req, _ = client.EC2Conn().TerminateInstancesRequest(&ec2.TerminateInstancesInput{
+		InstanceIds: aws.StringSlice([]string{"i-12345678"}),
+	})
+
+	err = req.Send()
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeReadOnlyOperation) {
+		t.Fatalf("expected %s error, got: %v", ErrCodeReadOnlyOperation, err)
+	}
+
+	if req.HTTPResponse != nil {
+		t.Error("expected request not to be sent")
+	}
+}
+
+func TestConfigClientReadOnlyAssumeRole(t *testing.T) {
+	oldEnv := testUnsetEnv(t)
+	defer testRestoreEnv(t, oldEnv)
+
+	stsServer := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleValidEndpoint,
+		awsbase.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer stsServer.Close()
+
+	config := &Config{
+		AccessKey:             awsbase.MockStaticAccessKey,
+		AssumeRoleARN:         awsbase.MockStsAssumeRoleArn,
+		AssumeRoleSessionName: awsbase.MockStsAssumeRoleSessionName,
+		Endpoints:             map[string]string{STS: stsServer.URL},
+		ReadOnly:              true,
+		Region:                "us-east-1", //lintignore:AWSAT003
+		SecretKey:             awsbase.MockStaticSecretKey,
+		SkipGetEC2Platforms:   true,
+		SkipMetadataApiCheck:  true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conn := raw.(*AWSClient).EC2Conn()
+
+	value, err := conn.Config.Credentials.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := value.AccessKeyID, awsbase.MockStsAssumeRoleAccessKey; got != expected {
+		t.Fatalf("got access key %s, expected assumed role access key %s", got, expected)
+	}
+
+	req, _ := conn.TerminateInstancesRequest(&ec2.TerminateInstancesInput{
+		InstanceIds: aws.StringSlice([]string{"i-12345678"}),
+	})
+
+	err = req.Send()
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeReadOnlyOperation) {
+		t.Fatalf("expected %s error, got: %v", ErrCodeReadOnlyOperation, err)
+	}
+
+	if req.HTTPResponse != nil {
+		t.Error("expected request not to be sent")
+	}
+}
@@ -0,0 +1,338 @@
+package conns
+
+import (
+	"errors"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	// RetryModeStandard limits the number of retries across all service clients
+	// with a retry quota.
+	RetryModeStandard = "standard"
+
+	// RetryModeAdaptive additionally limits the rate of requests across all
+	// service clients once they are throttled.
+	RetryModeAdaptive = "adaptive"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeStandard,
+		RetryModeAdaptive,
+	}
+}
+
+// Retry quota costs, matching those used by the AWS SDKs' standard retry mode.
+const (
+	retryQuotaCapacity    = 500
+	retryQuotaCost        = 5
+	retryQuotaTimeoutCost = 10
+	retryQuotaNoRetryCost = 1
+)
+
+// timeNow returns the current time. It is replaced in tests.
+var timeNow = time.Now
+
+// retryQuota is a token bucket from which each retry must acquire tokens.
+// Successful requests return tokens to the bucket so that a sustained outage
+// stops retries without limiting retries of occasional transient errors.
+type retryQuota struct {
+	available int
+	capacity  int
+	lock      sync.Mutex
+}
+
+func newRetryQuota(capacity int) *retryQuota {
+	return &retryQuota{
+		available: capacity,
+		capacity:  capacity,
+	}
+}
+
+// acquire removes cost tokens from the bucket, returning false if there are
+// not enough available.
+func (q *retryQuota) acquire(cost int) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if cost > q.available {
+		return false
+	}
+
+	q.available -= cost
+
+	return true
+}
+
+// release returns amount tokens to the bucket.
+func (q *retryQuota) release(amount int) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.available += amount
+
+	if q.available > q.capacity {
+		q.available = q.capacity
+	}
+}
+
+// adaptiveRateLimit is a client-side rate limiter using the CUBIC congestion
+// control algorithm, as used by the AWS SDKs' adaptive retry mode.
+// Requests are not limited until the first throttling error. The allowed rate
+// is then reduced after each throttling error and grows again as requests
+// succeed.
+type adaptiveRateLimit struct {
+	enabled bool
+	lock    sync.Mutex
+
+	// Token bucket.
+	capacity     float64
+	fillRate     float64
+	lastRefilled time.Time
+	tokens       float64
+
+	// Measured request rate.
+	lastTxRateBucket float64
+	measuredTxRate   float64
+	requestCount     int64
+
+	// CUBIC state.
+	calculatedRate   float64
+	lastMaxRate      float64
+	lastThrottleTime time.Time
+	timeWindow       float64
+}
+
+const (
+	adaptiveRateLimitBeta          = 0.7
+	adaptiveRateLimitMinCapacity   = 1.0
+	adaptiveRateLimitMinFillRate   = 0.5
+	adaptiveRateLimitScaleConstant = 0.4
+	adaptiveRateLimitSmooth        = 0.8
+)
+
+func newAdaptiveRateLimit() *adaptiveRateLimit {
+	now := timeNow()
+
+	return &adaptiveRateLimit{
+		lastThrottleTime: now,
+		lastTxRateBucket: math.Floor(seconds(now)),
+	}
+}
+
+// acquire takes a token for a request, returning false and the time to wait
+// before trying again if there is none available.
+func (l *adaptiveRateLimit) acquire() (bool, time.Duration) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if !l.enabled {
+		return true, 0
+	}
+
+	l.refill()
+
+	if l.tokens < 1 {
+		return false, time.Duration(math.Ceil((1 - l.tokens) / l.fillRate * float64(time.Second)))
+	}
+
+	l.tokens--
+
+	return true, 0
+}
+
+// update adjusts the allowed rate after a request attempt.
+func (l *adaptiveRateLimit) update(throttled bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := timeNow()
+
+	l.updateMeasuredRate(now)
+
+	if throttled {
+		rate := l.measuredTxRate
+
+		if l.enabled {
+			rate = math.Min(rate, l.fillRate)
+		}
+
+		l.lastMaxRate = rate
+		l.calculateTimeWindow()
+		l.lastThrottleTime = now
+		l.calculatedRate = rate * adaptiveRateLimitBeta
+		l.enabled = true
+	} else {
+		l.calculateTimeWindow()
+		l.calculatedRate = adaptiveRateLimitScaleConstant*math.Pow(seconds(now)-seconds(l.lastThrottleTime)-l.timeWindow, 3) + l.lastMaxRate
+	}
+
+	rate := math.Min(l.calculatedRate, 2*l.measuredTxRate)
+
+	l.refill()
+	l.fillRate = math.Max(rate, adaptiveRateLimitMinFillRate)
+	l.capacity = math.Max(rate, adaptiveRateLimitMinCapacity)
+	l.tokens = math.Min(l.tokens, l.capacity)
+}
+
+func (l *adaptiveRateLimit) calculateTimeWindow() {
+	l.timeWindow = math.Cbrt(l.lastMaxRate * (1 - adaptiveRateLimitBeta) / adaptiveRateLimitScaleConstant)
+}
+
+func (l *adaptiveRateLimit) refill() {
+	now := timeNow()
+
+	if !l.lastRefilled.IsZero() {
+		l.tokens = math.Min(l.tokens+now.Sub(l.lastRefilled).Seconds()*l.fillRate, l.capacity)
+	}
+
+	l.lastRefilled = now
+}
+
+func (l *adaptiveRateLimit) updateMeasuredRate(now time.Time) {
+	bucket := math.Floor(seconds(now)*2) / 2
+
+	l.requestCount++
+
+	if bucket > l.lastTxRateBucket {
+		rate := float64(l.requestCount) / (bucket - l.lastTxRateBucket)
+		l.measuredTxRate = rate*adaptiveRateLimitSmooth + l.measuredTxRate*(1-adaptiveRateLimitSmooth)
+		l.requestCount = 0
+		l.lastTxRateBucket = bucket
+	}
+}
+
+func seconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+// configureRetryMode adds request handlers implementing the specified retry
+// mode to the session. The retry quota and rate limit are shared by all service
+// clients created from the session.
+func configureRetryMode(sess *session.Session, mode string) {
+	if mode == "" {
+		return
+	}
+
+	quota := newRetryQuota(retryQuotaCapacity)
+
+	// Runs after any service specific retry handlers have decided whether the
+	// request is retryable and before the SDK waits to retry it.
+	sess.Handlers.AfterRetry.PushFrontNamed(request.NamedHandler{
+		Name: "tf.RetryQuotaHandler",
+		Fn: func(r *request.Request) {
+			var retryable bool
+
+			if r.Retryable != nil {
+				retryable = aws.BoolValue(r.Retryable)
+			} else {
+				retryable = r.ShouldRetry(r)
+			}
+
+			if retryable && r.RetryCount < r.MaxRetries() {
+				cost := retryQuotaCost
+
+				if isErrorTimeout(r.Error) {
+					cost = retryQuotaTimeoutCost
+				}
+
+				if !quota.acquire(cost) {
+					log.Printf("[WARN] Retry quota exceeded, not retrying %s/%s: %s", r.ClientInfo.ServiceName, r.Operation.Name, r.Error)
+					retryable = false
+				}
+			}
+
+			r.Retryable = aws.Bool(retryable)
+		},
+	})
+
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tf.RetryQuotaRefundHandler",
+		Fn: func(r *request.Request) {
+			if r.Error != nil {
+				return
+			}
+
+			if r.RetryCount > 0 {
+				quota.release(retryQuotaCost)
+			} else {
+				quota.release(retryQuotaNoRetryCost)
+			}
+		},
+	})
+
+	if mode != RetryModeAdaptive {
+		return
+	}
+
+	rateLimit := newAdaptiveRateLimit()
+
+	sess.Handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "tf.AdaptiveRateLimitHandler",
+		Fn: func(r *request.Request) {
+			for {
+				ok, delay := rateLimit.acquire()
+
+				if ok {
+					return
+				}
+
+				if sleepFn := r.Config.SleepDelay; sleepFn != nil {
+					sleepFn(delay)
+				} else if err := aws.SleepWithContext(r.Context(), delay); err != nil {
+					r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+					return
+				}
+			}
+		},
+	})
+
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "tf.AdaptiveRateLimitUpdateHandler",
+		Fn: func(r *request.Request) {
+			if r.HTTPResponse == nil {
+				return
+			}
+
+			rateLimit.update(isErrorThrottle(r))
+		},
+	})
+}
+
+func isErrorThrottle(r *request.Request) bool {
+	if r.HTTPResponse != nil && r.HTTPResponse.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	return request.IsErrorThrottle(r.Error)
+}
+
+func isErrorTimeout(err error) bool {
+	for err != nil {
+		var netErr net.Error
+
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return true
+		}
+
+		awsErr, ok := err.(awserr.Error)
+
+		if !ok {
+			return false
+		}
+
+		err = awsErr.OrigErr()
+	}
+
+	return false
+}
@@ -0,0 +1,309 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestRetryQuota(t *testing.T) {
+	quota := newRetryQuota(10)
+
+	if !quota.acquire(retryQuotaCost) {
+		t.Fatal("expected first acquire to succeed")
+	}
+
+	if !quota.acquire(retryQuotaCost) {
+		t.Fatal("expected second acquire to succeed")
+	}
+
+	if quota.acquire(retryQuotaCost) {
+		t.Fatal("expected acquire from empty quota to fail")
+	}
+
+	quota.release(retryQuotaNoRetryCost)
+
+	if quota.acquire(retryQuotaCost) {
+		t.Fatal("expected acquire from partially refilled quota to fail")
+	}
+
+	quota.release(100)
+
+	if got, expected := quota.available, 10; got != expected {
+		t.Errorf("got %d available, expected %d", got, expected)
+	}
+}
+
+func TestAdaptiveRateLimit(t *testing.T) {
+	clock := testFakeClock(t)
+
+	rateLimit := newAdaptiveRateLimit()
+
+	// Requests are not limited before the first throttling error.
+	for i := 0; i < 100; i++ {
+		if ok, _ := rateLimit.acquire(); !ok {
+			t.Fatalf("expected acquire %d before throttling to succeed", i)
+		}
+
+		rateLimit.update(false)
+		clock.advance(10 * time.Millisecond)
+	}
+
+	rateLimit.update(true)
+
+	if !rateLimit.enabled {
+		t.Fatal("expected rate limit to be enabled after throttling")
+	}
+
+	throttledRate := rateLimit.fillRate
+
+	if throttledRate >= rateLimit.measuredTxRate {
+		t.Errorf("expected fill rate (%f) to be reduced below measured rate (%f)", throttledRate, rateLimit.measuredTxRate)
+	}
+
+	var waited time.Duration
+
+	for i := 0; i < 10; i++ {
+		for {
+			ok, delay := rateLimit.acquire()
+
+			if ok {
+				break
+			}
+
+			if delay <= 0 {
+				t.Fatal("expected positive delay when no token is available")
+			}
+
+			waited += delay
+			clock.advance(delay)
+		}
+	}
+
+	if waited == 0 {
+		t.Error("expected requests to be delayed after throttling")
+	}
+
+	// The allowed rate grows again as requests succeed.
+	for start := clock.time(); clock.time().Sub(start) < 10*time.Second; {
+		if ok, delay := rateLimit.acquire(); !ok {
+			clock.advance(delay)
+			continue
+		}
+
+		rateLimit.update(false)
+	}
+
+	if rateLimit.fillRate <= throttledRate {
+		t.Errorf("expected fill rate (%f) to grow above throttled rate (%f)", rateLimit.fillRate, throttledRate)
+	}
+}
+
+func TestConfigClientRetryMode(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		RetryMode            string
+		Throttles            int
+		ExpectedLastAttempts int
+	}{
+		{
+			Name:                 "legacy",
+			Throttles:            1000,
+			ExpectedLastAttempts: 4,
+		},
+		{
+			Name:                 "standard quota exceeded",
+			RetryMode:            RetryModeStandard,
+			Throttles:            1000,
+			ExpectedLastAttempts: 1,
+		},
+		{
+			Name:                 "adaptive quota exceeded",
+			RetryMode:            RetryModeAdaptive,
+			Throttles:            1000,
+			ExpectedLastAttempts: 1,
+		},
+		{
+			Name:                 "standard recovers",
+			RetryMode:            RetryModeStandard,
+			Throttles:            2,
+			ExpectedLastAttempts: 1,
+		},
+		{
+			Name:                 "adaptive recovers",
+			RetryMode:            RetryModeAdaptive,
+			Throttles:            2,
+			ExpectedLastAttempts: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			oldEnv := testUnsetEnv(t)
+			defer testRestoreEnv(t, oldEnv)
+
+			clock := testFakeClock(t)
+			server := newTestThrottlingServer(testCase.Throttles)
+			defer server.Close()
+
+			config := &Config{
+				AccessKey:               awsbase.MockStaticAccessKey,
+				Endpoints:               map[string]string{IAM: server.URL, STS: server.URL},
+				MaxRetries:              3,
+				Region:                  "us-east-1", //lintignore:AWSAT003
+				RetryMode:               testCase.RetryMode,
+				SecretKey:               awsbase.MockStaticSecretKey,
+				SkipCredsValidation:     true,
+				SkipGetEC2Platforms:     true,
+				SkipMetadataApiCheck:    true,
+				SkipRequestingAccountId: true,
+			}
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			client := raw.(*AWSClient)
+
+			var sleeps int
+			client.session.Config.SleepDelay = func(d time.Duration) {
+				sleeps++
+				clock.advance(d)
+			}
+
+			// Alternate between service clients so that any retry quota and rate
+			// limit must be shared between them to take effect.
+			var attempts int
+
+			for i := 0; i < 40; i++ {
+				var req *request.Request
+
+				if i%2 == 0 {
+					req, _ = client.STSConn().GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+				} else {
+					req, _ = client.IAMConn().GetUserRequest(&iam.GetUserInput{})
+				}
+
+				before := server.requests()
+				err = req.Send()
+				attempts = server.requests() - before
+
+				if testCase.Throttles < 1000 && err != nil {
+					t.Fatalf("unexpected error on request %d: %s", i, err)
+				}
+
+				if testCase.Throttles >= 1000 && !request.IsErrorThrottle(err) {
+					t.Fatalf("expected throttling error on request %d, got: %v", i, err)
+				}
+			}
+
+			if got, expected := attempts, testCase.ExpectedLastAttempts; got != expected {
+				t.Errorf("got %d attempts for last request, expected %d", got, expected)
+			}
+
+			if testCase.RetryMode == RetryModeAdaptive && testCase.Throttles < 1000 {
+				// Only the 2 throttled attempts are retried. Any other delays are
+				// from the rate limit.
+				if sleeps <= testCase.Throttles {
+					t.Errorf("expected requests to be rate limited after throttling, got %d delays", sleeps)
+				}
+			}
+		})
+	}
+}
+
+// testClock is a fake clock for the retry mode rate limit.
+type testClock struct {
+	lock sync.Mutex
+	now  time.Time
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func (c *testClock) time() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.now
+}
+
+func testFakeClock(t *testing.T) *testClock {
+	clock := &testClock{now: time.Now()}
+	old := timeNow
+	timeNow = clock.time
+
+	t.Cleanup(func() {
+		timeNow = old
+	})
+
+	return clock
+}
+
+// testThrottlingServer is an STS and IAM API stand-in that returns Throttling
+// errors for the first requests it receives.
+type testThrottlingServer struct {
+	*httptest.Server
+
+	count     int
+	lock      sync.Mutex
+	throttles int
+}
+
+func newTestThrottlingServer(throttles int) *testThrottlingServer {
+	s := &testThrottlingServer{throttles: throttles}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+func (s *testThrottlingServer) requests() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.count
+}
+
+func (s *testThrottlingServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	s.count++
+	throttle := s.count <= s.throttles
+	s.lock.Unlock()
+
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+
+	if throttle {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId></ErrorResponse>`)
+		return
+	}
+
+	switch r.Form.Get("Action") {
+	case "GetCallerIdentity":
+		fmt.Fprint(w, awsbase.MockStsGetCallerIdentityValidResponseBody)
+	case "GetUser":
+		fmt.Fprint(w, `<GetUserResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/"><GetUserResult><User><Arn>arn:aws:iam::222222222222:user/Alice</Arn><UserName>Alice</UserName></User></GetUserResult></GetUserResponse>`)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>InvalidAction</Code><Message>Unsupported action</Message></Error><RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId></ErrorResponse>`)
+	}
+}
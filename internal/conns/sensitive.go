@@ -0,0 +1,90 @@
+package conns
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+)
+
+// SensitiveValues returns the string values of the API operation parameters or
+// results in v that are redacted from the API audit log, i.e. those marked as
+// sensitive by the AWS SDK or with sensitive names. Binary values are returned
+// base64 encoded, as they are sent in API requests.
+func SensitiveValues(v interface{}) []string {
+	return sensitiveValues(reflect.ValueOf(v), false, nil)
+}
+
+func sensitiveValues(v reflect.Value, sensitive bool, values []string) []string {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return values
+		}
+
+		if _, ok := v.Interface().(io.Reader); ok {
+			return values
+		}
+
+		return sensitiveValues(v.Elem(), sensitive, values)
+
+	case reflect.Struct:
+		if _, ok := v.Interface().(time.Time); ok {
+			return values
+		}
+
+		// Shapes that are sensitive as a whole are marked on their metadata field.
+		if field, ok := v.Type().FieldByName("_"); ok && field.Tag.Get("sensitive") == "true" {
+			sensitive = true
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			fieldSensitive := sensitive || field.Tag.Get("sensitive") == "true" || auditLogSensitiveName(field.Name)
+			values = sensitiveValues(v.Field(i), fieldSensitive, values)
+		}
+
+		return values
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if sensitive && v.Len() > 0 {
+				values = append(values, base64.StdEncoding.EncodeToString(v.Bytes()))
+			}
+
+			return values
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			values = sensitiveValues(v.Index(i), sensitive, values)
+		}
+
+		return values
+
+	case reflect.Map:
+		iter := v.MapRange()
+
+		for iter.Next() {
+			k := fmt.Sprint(iter.Key().Interface())
+			values = sensitiveValues(iter.Value(), sensitive || auditLogSensitiveName(k), values)
+		}
+
+		return values
+
+	case reflect.String:
+		if sensitive && v.Len() > 0 {
+			values = append(values, v.String())
+		}
+
+		return values
+
+	default:
+		return values
+	}
+}
@@ -0,0 +1,95 @@
+package conns
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestSensitiveValues(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Params   interface{}
+		Expected []string
+	}{
+		{
+			Name:     "nil",
+			Params:   (*sts.GetCallerIdentityInput)(nil),
+			Expected: nil,
+		},
+		{
+			Name: "sensitive",
+			Params: &iam.CreateLoginProfileInput{
+				Password: aws.String("example-password"),
+				UserName: aws.String("example"),
+			},
+			Expected: []string{"example-password"},
+		},
+		{
+			Name: "sensitive name",
+			Params: &elasticache.CreateReplicationGroupInput{
+				AuthToken:          aws.String("example-token"),
+				ReplicationGroupId: aws.String("example"),
+			},
+			Expected: []string{"example-token"},
+		},
+		{
+			Name: "sensitive map key",
+			Params: &ecs.ContainerDefinition{
+				DockerLabels: aws.StringMap(map[string]string{
+					"db_password": "example-password",
+					"team":        "example",
+				}),
+			},
+			Expected: []string{"example-password"},
+		},
+		{
+			Name: "sensitive shape",
+			Params: &sts.AssumeRoleOutput{
+				Credentials: &sts.Credentials{
+					AccessKeyId:     aws.String("example-access-key"),
+					SecretAccessKey: aws.String("example-secret-key"),
+					SessionToken:    aws.String("example-session-token"),
+				},
+			},
+			Expected: []string{"example-access-key", "example-secret-key", "example-session-token"},
+		},
+		{
+			Name: "binary",
+			Params: &secretsmanager.PutSecretValueInput{
+				SecretBinary: []byte("example"),
+				SecretId:     aws.String("example"),
+			},
+			Expected: []string{"ZXhhbXBsZQ=="},
+		},
+		{
+			Name: "not sensitive",
+			Params: &iam.CreateRoleInput{
+				RoleName: aws.String("example"),
+				Tags: []*iam.Tag{
+					{
+						Key:   aws.String("Name"),
+						Value: aws.String("example"),
+					},
+				},
+			},
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := SensitiveValues(testCase.Params)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
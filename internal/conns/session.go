@@ -0,0 +1,403 @@
+package conns
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/mitchellh/go-homedir"
+)
+
+// sessionConfig holds the settings shared by all of the provider's AWS Go SDK
+// sessions, including those used to obtain credentials.
+type sessionConfig struct {
+	caBundle         []byte
+	httpClient       *http.Client
+	imdsEndpoint     string
+	imdsEndpointMode endpoints.EC2IMDSEndpointModeState
+}
+
+// newSessionConfig returns the settings shared by all of the provider's AWS Go
+// SDK sessions.
+// They are applied as session options rather than through the AWS Go SDK
+// environment variables so that concurrently configured providers do not
+// affect each other.
+func (c *Config) newSessionConfig() (*sessionConfig, error) {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+
+	if c.Insecure {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
+		}
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	s := &sessionConfig{
+		httpClient:   client,
+		imdsEndpoint: c.EC2MetadataServiceEndpoint,
+	}
+
+	if c.CustomCABundle != "" {
+		path, err := homedir.Expand(c.CustomCABundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding custom CA bundle file name: %w", err)
+		}
+
+		s.caBundle, err = ioutil.ReadFile(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle: %w", err)
+		}
+
+		// As with the AWS Go SDK, only the certificates in the bundle are trusted.
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(s.caBundle) {
+			return nil, fmt.Errorf("error reading custom CA bundle: no certificates found in %s", path)
+		}
+
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if err := s.imdsEndpointMode.SetFromString(c.EC2MetadataServiceEndpointMode); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// isDefault returns whether the settings are those of the sessions created by
+// aws-sdk-go-base.
+func (s *sessionConfig) isDefault() bool {
+	return len(s.caBundle) == 0 && s.imdsEndpoint == "" && s.imdsEndpointMode == endpoints.EC2IMDSEndpointModeStateUnset
+}
+
+// options returns AWS Go SDK session options for the specified configuration
+// with the shared settings applied.
+func (s *sessionConfig) options(config aws.Config) session.Options {
+	options := session.Options{
+		Config:              config,
+		EC2IMDSEndpoint:     s.imdsEndpoint,
+		EC2IMDSEndpointMode: s.imdsEndpointMode,
+	}
+
+	// The shared HTTP client already trusts the CA bundle.
+	if config.HTTPClient == nil && len(s.caBundle) > 0 {
+		options.CustomCABundle = bytes.NewReader(s.caBundle)
+	}
+
+	return options
+}
+
+// endpointResolver returns resolver with the EC2 Instance Metadata Service
+// endpoint settings applied, as the AWS Go SDK does for session options.
+func (s *sessionConfig) endpointResolver(resolver endpoints.Resolver) endpoints.Resolver {
+	return endpoints.ResolverFunc(func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		if service == ec2metadata.ServiceName {
+			if s.imdsEndpoint != "" {
+				return endpoints.ResolvedEndpoint{
+					URL:           s.imdsEndpoint,
+					SigningName:   service,
+					SigningRegion: region,
+				}, nil
+			}
+
+			optFns = append(optFns, func(o *endpoints.Options) {
+				o.EC2MetadataEndpointMode = s.imdsEndpointMode
+			})
+		}
+
+		return resolver.EndpointFor(service, region, optFns...)
+	})
+}
+
+// endpointVariantsConfig returns the AWS Go SDK configuration that selects the
+// FIPS and dual-stack endpoints for the STS and IAM clients used for the
+// provider's credentials. An explicitly configured endpoint is used as-is.
+func (c *Config) endpointVariantsConfig() *aws.Config {
+	config := &aws.Config{}
+
+	if c.UseDualStackEndpoint {
+		config.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+	}
+
+	if c.UseFIPSEndpoint {
+		config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+	}
+
+	return config
+}
+
+// newSession returns the provider's AWS Go SDK session along with the account ID
+// and partition, if available.
+// aws-sdk-go-base does not support web identity credentials, a custom CA bundle,
+// EC2 metadata endpoint settings or FIPS and dual-stack endpoints. When any of
+// these is configured, the provider's credentials are obtained here and
+// aws-sdk-go-base only builds the session, with the credentials validated and
+// the account ID found using the provider's settings.
+func (c *Config) newSession(awsbaseConfig *awsbase.Config) (*session.Session, string, string, error) {
+	sessionConfig, err := c.newSessionConfig()
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	if c.AssumeRoleWithWebIdentityRoleARN == "" && sessionConfig.isDefault() && !c.UseDualStackEndpoint && !c.UseFIPSEndpoint {
+		return awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
+	}
+
+	if c.SkipMetadataApiCheck {
+		os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	}
+
+	creds, err := c.credentials(awsbaseConfig, sessionConfig)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	value, err := creds.Get()
+
+	if err != nil {
+		return nil, "", "", awsbaseConfig.NewNoValidCredentialSourcesError(err)
+	}
+
+	// aws-sdk-go-base builds the session with the current credentials without
+	// making any requests. They are then replaced by the refreshed credentials.
+	sessionAwsbaseConfig := *awsbaseConfig
+	sessionAwsbaseConfig.AccessKey = value.AccessKeyID
+	sessionAwsbaseConfig.AssumeRoleARN = ""
+	sessionAwsbaseConfig.SecretKey = value.SecretAccessKey
+	sessionAwsbaseConfig.SkipCredsValidation = true
+	sessionAwsbaseConfig.SkipRequestingAccountId = true
+	sessionAwsbaseConfig.Token = value.SessionToken
+
+	sess, err := awsbase.GetSession(&sessionAwsbaseConfig)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	sess = sess.Copy(&aws.Config{
+		Credentials:      creds,
+		EndpointResolver: sessionConfig.endpointResolver(awsbaseConfig.EndpointResolver()),
+		HTTPClient:       sessionConfig.httpClient,
+	})
+
+	accountID, partition, err := c.accountIDAndPartition(sess, value.ProviderName)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return sess, accountID, partition, nil
+}
+
+// accountIDAndPartition returns the account ID and partition for the session's
+// credentials in the same way as aws-sdk-go-base's
+// GetSessionWithAccountIDAndPartition.
+func (c *Config) accountIDAndPartition(sess *session.Session, providerName string) (string, string, error) {
+	stsClient := sts.New(sess, c.endpointVariantsConfig())
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsClient)
+
+		if err != nil {
+			return "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+
+		if c.AssumeRoleARN == "" {
+			return accountID, partition, nil
+		}
+	}
+
+	if c.AssumeRoleARN != "" {
+		if v, err := arn.Parse(c.AssumeRoleARN); err == nil {
+			return v.AccountID, v.Partition, nil
+		}
+
+		return "", "", nil
+	}
+
+	if !c.SkipRequestingAccountId {
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iam.New(sess, c.endpointVariantsConfig()), stsClient, providerName)
+
+		if err != nil {
+			return "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %w", err)
+		}
+
+		return accountID, partition, nil
+	}
+
+	var partition string
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return "", partition, nil
+}
+
+// credentials returns the provider's credentials.
+// The source credentials are those for assume_role_with_web_identity, if
+// configured, or otherwise those found in the same way as aws-sdk-go-base. If
+// assume_role is configured, the returned credentials assume the role with the
+// source credentials, both being refreshed when they expire.
+func (c *Config) credentials(awsbaseConfig *awsbase.Config, sessionConfig *sessionConfig) (*credentials.Credentials, error) {
+	var creds *credentials.Credentials
+	var err error
+
+	if c.AssumeRoleWithWebIdentityRoleARN != "" {
+		creds, err = c.webIdentityCredentials(sessionConfig)
+	} else {
+		creds, err = c.sourceCredentials(awsbaseConfig, sessionConfig)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if c.AssumeRoleARN == "" {
+		return creds, nil
+	}
+
+	role := c.assumeRole()
+
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", role.RoleARN, role.SessionName, role.ExternalID)
+
+	awsConfig := aws.Config{
+		Credentials:                   creds,
+		CredentialsChainVerboseErrors: aws.Bool(true),
+		EndpointResolver:              awsbaseConfig.EndpointResolver(),
+		HTTPClient:                    sessionConfig.httpClient,
+		MaxRetries:                    aws.Int(c.MaxRetries),
+		Region:                        aws.String(c.Region),
+	}
+
+	if awsbaseConfig.DebugLogging {
+		awsConfig.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		awsConfig.Logger = awsbase.DebugLogger{}
+	}
+
+	sess, err := session.NewSessionWithOptions(sessionConfig.options(awsConfig))
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role session: %w", err)
+	}
+
+	creds = c.assumeRoleCredentials(sess, role)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, awsbaseConfig.NewCannotAssumeRoleError(err)
+	}
+
+	return creds, nil
+}
+
+// sourceCredentials returns credentials from the provider configuration, the
+// environment or the shared credentials file, falling back to those derived
+// from an AWS Go SDK session (which may use a credential process or the ECS or
+// EC2 metadata endpoints).
+// It follows aws-sdk-go-base's GetCredentials, but the fallback session uses
+// the provider's session settings.
+func (c *Config) sourceCredentials(awsbaseConfig *awsbase.Config, sessionConfig *sessionConfig) (*credentials.Credentials, error) {
+	sharedCredentialsFilename, err := homedir.Expand(c.CredsFilename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding shared credentials filename: %w", err)
+	}
+
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.StaticProvider{Value: credentials.Value{
+			AccessKeyID:     c.AccessKey,
+			SecretAccessKey: c.SecretKey,
+			SessionToken:    c.Token,
+		}},
+		&credentials.EnvProvider{},
+		&credentials.SharedCredentialsProvider{
+			Filename: sharedCredentialsFilename,
+			Profile:  c.Profile,
+		},
+	})
+
+	value, err := creds.Get()
+
+	if err == nil {
+		log.Printf("[INFO] AWS Auth provider used: %q", value.ProviderName)
+
+		return creds, nil
+	}
+
+	if !tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+		return nil, fmt.Errorf("error loading credentials for AWS Provider: %w", err)
+	}
+
+	log.Printf("[INFO] Attempting to use session-derived credentials")
+
+	// The HTTP client is not set so that the EC2 metadata client can lower its
+	// timeout when it is not running on EC2.
+	options := sessionConfig.options(aws.Config{
+		CredentialsChainVerboseErrors: aws.Bool(true),
+		EndpointResolver:              awsbaseConfig.EndpointResolver(),
+		MaxRetries:                    aws.Int(0),
+		Region:                        aws.String(c.Region),
+	})
+	options.Profile = c.Profile
+	options.SharedConfigState = session.SharedConfigEnable
+
+	sess, err := session.NewSessionWithOptions(options)
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+			return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+		}
+
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	value, err = sess.Config.Credentials.Get()
+
+	if err != nil {
+		return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+	}
+
+	log.Printf("[INFO] Successfully derived credentials from session")
+	log.Printf("[INFO] AWS Auth provider used: %q", value.ProviderName)
+
+	return sess.Config.Credentials, nil
+}
@@ -0,0 +1,671 @@
+package conns
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigClientCustomCABundle(t *testing.T) {
+	oldEnv := testUnsetEnv(t)
+	defer testRestoreEnv(t, oldEnv)
+
+	if v, ok := os.LookupEnv(EnvVarCABundle); ok {
+		oldEnv[EnvVarCABundle] = v
+	}
+
+	os.Unsetenv(EnvVarCABundle)
+
+	stsServer := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer stsServer.Close()
+
+	ts := httptest.NewTLSServer(stsServer.Config.Handler)
+	defer ts.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca-bundle.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+
+	if err := ioutil.WriteFile(caBundle, pemBytes, 0600); err != nil {
+		t.Fatalf("error writing CA bundle: %s", err)
+	}
+
+	testCases := []struct {
+		Name           string
+		CustomCABundle string
+		ExpectError    string
+	}{
+		{
+			Name:        "no bundle",
+			ExpectError: "certificate",
+		},
+		{
+			Name:           "bundle",
+			CustomCABundle: caBundle,
+		},
+		{
+			Name:           "missing bundle",
+			CustomCABundle: filepath.Join(t.TempDir(), "missing.pem"),
+			ExpectError:    "error reading custom CA bundle",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				AccessKey:            awsbase.MockStaticAccessKey,
+				CustomCABundle:       testCase.CustomCABundle,
+				Endpoints:            map[string]string{STS: ts.URL},
+				MaxRetries:           1,
+				Region:               "us-east-1", //lintignore:AWSAT003
+				SecretKey:            awsbase.MockStaticSecretKey,
+				SkipGetEC2Platforms:  true,
+				SkipMetadataApiCheck: true,
+			}
+
+			raw, err := config.Client()
+
+			if testCase.ExpectError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.ExpectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := raw.(*AWSClient).AccountID, awsbase.MockStsGetCallerIdentityAccountID; got != expected {
+				t.Errorf("got account ID %s, expected %s", got, expected)
+			}
+
+			if v, ok := os.LookupEnv(EnvVarCABundle); ok {
+				t.Errorf("expected %s not to be set, got %q", EnvVarCABundle, v)
+			}
+		})
+	}
+}
+
+func TestConfigClientCustomCABundleConcurrent(t *testing.T) {
+	oldEnv := testUnsetEnv(t)
+	defer testRestoreEnv(t, oldEnv)
+
+	stsServer := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer stsServer.Close()
+
+	ts := httptest.NewTLSServer(stsServer.Config.Handler)
+	defer ts.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca-bundle.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+
+	if err := ioutil.WriteFile(caBundle, pemBytes, 0600); err != nil {
+		t.Fatalf("error writing CA bundle: %s", err)
+	}
+
+	// Each provider configuration only trusts its own CA bundle.
+	const n = 10
+
+	errs := make(chan error, 2*n)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 2*n; i++ {
+		withBundle := i%2 == 0
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			config := &Config{
+				AccessKey:            awsbase.MockStaticAccessKey,
+				Endpoints:            map[string]string{STS: ts.URL},
+				MaxRetries:           1,
+				Region:               "us-east-1", //lintignore:AWSAT003
+				SecretKey:            awsbase.MockStaticSecretKey,
+				SkipGetEC2Platforms:  true,
+				SkipMetadataApiCheck: true,
+			}
+
+			if withBundle {
+				config.CustomCABundle = caBundle
+			}
+
+			_, err := config.Client()
+
+			switch {
+			case withBundle && err != nil:
+				errs <- fmt.Errorf("unexpected error with CA bundle: %w", err)
+			case !withBundle && err == nil:
+				errs <- fmt.Errorf("expected error without CA bundle, got none")
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestConfigClientEndpointVariantsCredentials(t *testing.T) {
+	oldEnv := testUnsetEnv(t)
+	defer testRestoreEnv(t, oldEnv)
+
+	testCases := []struct {
+		Name                 string
+		Config               *Config
+		UseDualStackEndpoint bool
+		UseFIPSEndpoint      bool
+		ExpectedHostname     string
+	}{
+		{
+			Name:             "FIPS validation",
+			Config:           &Config{},
+			UseFIPSEndpoint:  true,
+			ExpectedHostname: "sts-fips.us-west-2.amazonaws.com",
+		},
+		{
+			Name:                 "dual-stack validation",
+			Config:               &Config{},
+			UseDualStackEndpoint: true,
+			ExpectedHostname:     "sts.us-west-2.api.aws",
+		},
+		{
+			Name: "FIPS account ID lookup",
+			Config: &Config{
+				SkipCredsValidation: true,
+			},
+			UseFIPSEndpoint:  true,
+			ExpectedHostname: "iam-fips.amazonaws.com",
+		},
+		{
+			Name: "FIPS assume role",
+			Config: &Config{
+				AssumeRoleARN:         awsbase.MockStsAssumeRoleArn,
+				AssumeRoleSessionName: awsbase.MockStsAssumeRoleSessionName,
+			},
+			UseFIPSEndpoint:  true,
+			ExpectedHostname: "sts-fips.us-west-2.amazonaws.com",
+		},
+		{
+			Name: "FIPS web identity",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+			},
+			UseFIPSEndpoint:  true,
+			ExpectedHostname: "sts-fips.us-west-2.amazonaws.com",
+		},
+		{
+			Name: "FIPS explicit endpoint",
+			Config: &Config{
+				Endpoints: map[string]string{STS: "https://sts.example.com"},
+			},
+			UseFIPSEndpoint:  true,
+			ExpectedHostname: "sts.example.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// The proxy records the host of the first request and fails all requests.
+			hosts := make(chan string, 1)
+
+			proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case hosts <- r.Host:
+				default:
+				}
+
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+			defer proxy.Close()
+
+			config := testCase.Config
+			config.HTTPProxy = proxy.URL
+			config.Region = "us-west-2" //lintignore:AWSAT003
+			config.SkipGetEC2Platforms = true
+			config.SkipMetadataApiCheck = true
+			config.UseDualStackEndpoint = testCase.UseDualStackEndpoint
+			config.UseFIPSEndpoint = testCase.UseFIPSEndpoint
+
+			if config.AssumeRoleWithWebIdentityRoleARN == "" {
+				config.AccessKey = awsbase.MockStaticAccessKey
+				config.SecretKey = awsbase.MockStaticSecretKey
+			}
+
+			if _, err := config.Client(); err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			select {
+			case host := <-hosts:
+				if got, expected := strings.TrimSuffix(host, ":443"), testCase.ExpectedHostname; got != expected {
+					t.Errorf("got hostname %s, expected %s", got, expected)
+				}
+			default:
+				t.Fatal("expected request, got none")
+			}
+		})
+	}
+}
+
+func TestConfigClientEC2MetadataServiceEndpoint(t *testing.T) {
+	oldEnv := testUnsetEnv(t)
+	defer testRestoreEnv(t, oldEnv)
+
+	for _, k := range []string{
+		"AWS_CONTAINER_CREDENTIALS_FULL_URI",
+		"AWS_CONTAINER_CREDENTIALS_RELATIVE_URI",
+		"AWS_EC2_METADATA_DISABLED",
+		EnvVarEC2MetadataServiceEndpoint,
+		EnvVarEC2MetadataServiceEndpointMode,
+	} {
+		if v, ok := os.LookupEnv(k); ok {
+			oldEnv[k] = v
+		}
+
+		os.Unsetenv(k)
+	}
+
+	os.Setenv("AWS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+	defer os.Unsetenv("AWS_CONFIG_FILE")
+	defer os.Unsetenv("AWS_SHARED_CREDENTIALS_FILE")
+
+	imdsServer := httptest.NewServer(testEC2MetadataHandler())
+	defer imdsServer.Close()
+
+	stsServer := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer stsServer.Close()
+
+	testCases := []struct {
+		Name                           string
+		Config                         *Config
+		ExpectedCredentialsProvider    string
+		ExpectedEC2MetadataEndpointURL string
+	}{
+		{
+			Name: "endpoint",
+			Config: &Config{
+				EC2MetadataServiceEndpoint: imdsServer.URL,
+			},
+			ExpectedCredentialsProvider:    ec2rolecreds.ProviderName,
+			ExpectedEC2MetadataEndpointURL: imdsServer.URL,
+		},
+		{
+			Name: "IPv6 mode",
+			Config: &Config{
+				AccessKey:                      awsbase.MockStaticAccessKey,
+				EC2MetadataServiceEndpointMode: EC2MetadataServiceEndpointModeIPv6,
+				SecretKey:                      awsbase.MockStaticSecretKey,
+			},
+			ExpectedEC2MetadataEndpointURL: "http://[fd00:ec2::254]/latest",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := testCase.Config
+			config.Endpoints = map[string]string{STS: stsServer.URL}
+			config.MaxRetries = 1
+			config.Region = "us-east-1" //lintignore:AWSAT003
+			config.SkipGetEC2Platforms = true
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			client := raw.(*AWSClient)
+
+			if got, expected := client.session.ClientConfig(ec2metadata.ServiceName).Endpoint, testCase.ExpectedEC2MetadataEndpointURL; got != expected {
+				t.Errorf("got EC2 metadata endpoint %s, expected %s", got, expected)
+			}
+
+			if testCase.ExpectedCredentialsProvider != "" {
+				value, err := client.session.Config.Credentials.Get()
+
+				if err != nil {
+					t.Fatalf("unexpected error getting credentials: %s", err)
+				}
+
+				if got, expected := value.ProviderName, testCase.ExpectedCredentialsProvider; got != expected {
+					t.Errorf("got credentials provider %s, expected %s", got, expected)
+				}
+			}
+
+			for _, k := range []string{EnvVarEC2MetadataServiceEndpoint, EnvVarEC2MetadataServiceEndpointMode} {
+				if v, ok := os.LookupEnv(k); ok {
+					t.Errorf("expected %s not to be set, got %q", k, v)
+				}
+			}
+		})
+	}
+}
+
+// TestConfigClientCredentialsPaths verifies that the provider's credentials are
+// the same whether the session is created by aws-sdk-go-base or, when settings
+// that aws-sdk-go-base does not support are configured, by newSession.
+func TestConfigClientCredentialsPaths(t *testing.T) {
+	oldEnv := testUnsetEnv(t)
+	defer testRestoreEnv(t, oldEnv)
+
+	for _, k := range []string{
+		"AWS_CONTAINER_CREDENTIALS_FULL_URI",
+		"AWS_CONTAINER_CREDENTIALS_RELATIVE_URI",
+		"AWS_EC2_METADATA_DISABLED",
+		EnvVarEC2MetadataServiceEndpoint,
+		EnvVarEC2MetadataServiceEndpointMode,
+	} {
+		if v, ok := os.LookupEnv(k); ok {
+			oldEnv[k] = v
+		}
+
+		os.Unsetenv(k)
+	}
+
+	ecsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"AccessKeyId":%q,"Expiration":%q,"SecretAccessKey":%q,"Token":%q}`,
+			awsbase.MockEcsCredentialsAccessKey,
+			time.Now().UTC().Add(1*time.Hour).Format(time.RFC3339),
+			awsbase.MockEcsCredentialsSecretKey,
+			awsbase.MockEcsCredentialsSessionToken,
+		)
+	}))
+	defer ecsServer.Close()
+
+	testCases := []struct {
+		Name                    string
+		Config                  Config
+		EnvironmentVariables    map[string]string
+		MockStsEndpoints        []*awsbase.MockEndpoint
+		SharedConfigurationFile string
+		SharedCredentialsFile   string
+		ExpectedAccessKeyID     string
+		ExpectedAccountID       string
+		ExpectedError           func(err error) bool
+	}{
+		{
+			Name: "profile shared credentials",
+			Config: Config{
+				Profile: "example",
+			},
+			MockStsEndpoints: []*awsbase.MockEndpoint{
+				awsbase.MockStsGetCallerIdentityValidEndpoint,
+			},
+			SharedCredentialsFile: `
+[example]
+aws_access_key_id = ProfileSharedCredentialsAccessKey
+aws_secret_access_key = ProfileSharedCredentialsSecretKey
+`,
+			ExpectedAccessKeyID: "ProfileSharedCredentialsAccessKey",
+			ExpectedAccountID:   awsbase.MockStsGetCallerIdentityAccountID,
+		},
+		{
+			Name: "profile shared configuration source_profile",
+			Config: Config{
+				Profile: "example",
+			},
+			MockStsEndpoints: []*awsbase.MockEndpoint{
+				awsbase.MockStsAssumeRoleValidEndpoint,
+				awsbase.MockStsGetCallerIdentityValidEndpoint,
+			},
+			SharedConfigurationFile: fmt.Sprintf(`
+[profile example]
+role_arn = %[1]s
+role_session_name = %[2]s
+source_profile = source
+
+[profile source]
+aws_access_key_id = SharedConfigurationSourceAccessKey
+aws_secret_access_key = SharedConfigurationSourceSecretKey
+`, awsbase.MockStsAssumeRoleArn, awsbase.MockStsAssumeRoleSessionName),
+			ExpectedAccessKeyID: awsbase.MockStsAssumeRoleAccessKey,
+			ExpectedAccountID:   awsbase.MockStsGetCallerIdentityAccountID,
+		},
+		{
+			Name: "profile not found",
+			Config: Config{
+				Profile: "missing",
+			},
+			SharedCredentialsFile: `
+[example]
+aws_access_key_id = ProfileSharedCredentialsAccessKey
+aws_secret_access_key = ProfileSharedCredentialsSecretKey
+`,
+			ExpectedError: awsbase.IsNoValidCredentialSourcesError,
+		},
+		{
+			Name: "container credentials",
+			EnvironmentVariables: map[string]string{
+				"AWS_CONTAINER_CREDENTIALS_FULL_URI": ecsServer.URL + "/creds",
+			},
+			MockStsEndpoints: []*awsbase.MockEndpoint{
+				awsbase.MockStsGetCallerIdentityValidEndpoint,
+			},
+			ExpectedAccessKeyID: awsbase.MockEcsCredentialsAccessKey,
+			ExpectedAccountID:   awsbase.MockStsGetCallerIdentityAccountID,
+		},
+		{
+			Name: "container credentials assume_role",
+			Config: Config{
+				AssumeRoleARN:         awsbase.MockStsAssumeRoleArn,
+				AssumeRoleSessionName: awsbase.MockStsAssumeRoleSessionName,
+			},
+			EnvironmentVariables: map[string]string{
+				"AWS_CONTAINER_CREDENTIALS_FULL_URI": ecsServer.URL + "/creds",
+			},
+			MockStsEndpoints: []*awsbase.MockEndpoint{
+				awsbase.MockStsAssumeRoleValidEndpoint,
+				awsbase.MockStsGetCallerIdentityValidEndpoint,
+			},
+			ExpectedAccessKeyID: awsbase.MockStsAssumeRoleAccessKey,
+			ExpectedAccountID:   "555555555555",
+		},
+		{
+			Name: "assume_role invalid client token",
+			Config: Config{
+				AccessKey:             awsbase.MockStaticAccessKey,
+				AssumeRoleARN:         awsbase.MockStsAssumeRoleArn,
+				AssumeRoleSessionName: awsbase.MockStsAssumeRoleSessionName,
+				SecretKey:             awsbase.MockStaticSecretKey,
+			},
+			MockStsEndpoints: []*awsbase.MockEndpoint{
+				awsbase.MockStsAssumeRoleInvalidEndpointInvalidClientTokenId,
+				awsbase.MockStsGetCallerIdentityValidEndpoint,
+			},
+			ExpectedError: awsbase.IsCannotAssumeRoleError,
+		},
+		{
+			Name: "no credentials",
+			MockStsEndpoints: []*awsbase.MockEndpoint{
+				awsbase.MockStsGetCallerIdentityValidEndpoint,
+			},
+			ExpectedError: awsbase.IsNoValidCredentialSourcesError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			for k, v := range testCase.EnvironmentVariables {
+				os.Setenv(k, v)
+				defer os.Unsetenv(k)
+			}
+
+			configFile := filepath.Join(t.TempDir(), "config")
+			credentialsFile := filepath.Join(t.TempDir(), "credentials")
+
+			if err := ioutil.WriteFile(configFile, []byte(testCase.SharedConfigurationFile), 0600); err != nil {
+				t.Fatalf("error writing shared configuration file: %s", err)
+			}
+
+			if err := ioutil.WriteFile(credentialsFile, []byte(testCase.SharedCredentialsFile), 0600); err != nil {
+				t.Fatalf("error writing shared credentials file: %s", err)
+			}
+
+			os.Setenv("AWS_CONFIG_FILE", configFile)
+			defer os.Unsetenv("AWS_CONFIG_FILE")
+			defer os.Unsetenv("AWS_EC2_METADATA_DISABLED")
+
+			stsServer := awsbase.MockAwsApiServer("STS", testCase.MockStsEndpoints)
+			defer stsServer.Close()
+
+			type result struct {
+				accountID   string
+				credentials credentials.Value
+				err         error
+				partition   string
+			}
+
+			var results []result
+
+			// The default settings use aws-sdk-go-base's GetSessionWithAccountIDAndPartition.
+			// Setting the EC2 metadata endpoint mode, although to its default, uses newSession.
+			for _, endpointMode := range []string{"", EC2MetadataServiceEndpointModeIPv4} {
+				config := testCase.Config
+				config.CredsFilename = credentialsFile
+				config.EC2MetadataServiceEndpointMode = endpointMode
+				config.Endpoints = map[string]string{STS: stsServer.URL}
+				config.MaxRetries = 1
+				config.Region = "us-east-1" //lintignore:AWSAT003
+				config.SkipGetEC2Platforms = true
+				config.SkipMetadataApiCheck = true
+
+				raw, err := config.Client()
+
+				if err != nil {
+					results = append(results, result{err: err})
+					continue
+				}
+
+				client := raw.(*AWSClient)
+				value, err := client.session.Config.Credentials.Get()
+
+				if err != nil {
+					t.Fatalf("unexpected error getting credentials: %s", err)
+				}
+
+				results = append(results, result{
+					accountID:   client.AccountID,
+					credentials: value,
+					partition:   client.Partition,
+				})
+			}
+
+			awsbaseResult, providerResult := results[0], results[1]
+
+			if testCase.ExpectedError != nil {
+				if !testCase.ExpectedError(awsbaseResult.err) || !testCase.ExpectedError(providerResult.err) {
+					t.Fatalf("unexpected errors: %v and %v", awsbaseResult.err, providerResult.err)
+				}
+
+				// aws-sdk-go-base returns AssumeRole failures through a credential chain, which hides the underlying error.
+				if awsbase.IsCannotAssumeRoleError(awsbaseResult.err) {
+					return
+				}
+
+				if got, expected := providerResult.err.Error(), awsbaseResult.err.Error(); got != expected {
+					t.Errorf("got error:\n%s\nexpected error:\n%s", got, expected)
+				}
+
+				return
+			}
+
+			if awsbaseResult.err != nil || providerResult.err != nil {
+				t.Fatalf("unexpected errors: %v and %v", awsbaseResult.err, providerResult.err)
+			}
+
+			if got, expected := awsbaseResult.credentials.AccessKeyID, testCase.ExpectedAccessKeyID; got != expected {
+				t.Errorf("got aws-sdk-go-base access key %s, expected %s", got, expected)
+			}
+
+			if got, expected := awsbaseResult.accountID, testCase.ExpectedAccountID; got != expected {
+				t.Errorf("got aws-sdk-go-base account ID %s, expected %s", got, expected)
+			}
+
+			if got, expected := providerResult, awsbaseResult; !reflect.DeepEqual(got, expected) {
+				t.Errorf("got %+v, expected %+v", got, expected)
+			}
+		})
+	}
+}
+
+// testEC2MetadataHandler returns a handler serving the EC2 Instance Metadata
+// Service (IMDSv2) requests made when retrieving instance role credentials.
+func testEC2MetadataHandler() http.Handler {
+	const (
+		role  = "test-role"
+		token = "test-token"
+	)
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/latest/api/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("X-Aws-Ec2-Metadata-Token-Ttl-Seconds", r.Header.Get("X-Aws-Ec2-Metadata-Token-Ttl-Seconds"))
+		fmt.Fprint(w, token)
+	})
+
+	mux.HandleFunc("/latest/meta-data/iam/security-credentials/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Aws-Ec2-Metadata-Token") != token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch strings.TrimPrefix(r.URL.Path, "/latest/meta-data/iam/security-credentials/") {
+		case "":
+			fmt.Fprint(w, role)
+		case role:
+			fmt.Fprintf(w, `{
+  "Code": "Success",
+  "LastUpdated": %[1]q,
+  "Type": "AWS-HMAC",
+  "AccessKeyId": "IMDSAccessKey",
+  "SecretAccessKey": "IMDSSecretKey",
+  "Token": "IMDSSessionToken",
+  "Expiration": %[2]q
+}`, time.Now().UTC().Format(time.RFC3339), time.Now().UTC().Add(1*time.Hour).Format(time.RFC3339))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	return mux
+}
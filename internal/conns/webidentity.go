@@ -0,0 +1,77 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/mitchellh/go-homedir"
+)
+
+// webIdentityToken is a stscreds.TokenFetcher for a web identity token
+// configured inline.
+type webIdentityToken string
+
+func (t webIdentityToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
+
+// webIdentityCredentials returns credentials for the role configured via
+// assume_role_with_web_identity. The returned credentials call
+// AssumeRoleWithWebIdentity again when they expire.
+func (c *Config) webIdentityCredentials(sessionConfig *sessionConfig) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)", c.AssumeRoleWithWebIdentityRoleARN, c.AssumeRoleWithWebIdentitySessionName)
+
+	// AssumeRoleWithWebIdentity requests are not signed.
+	sess, err := session.NewSessionWithOptions(sessionConfig.options(aws.Config{
+		Credentials:                   credentials.AnonymousCredentials,
+		CredentialsChainVerboseErrors: aws.Bool(true),
+		Endpoint:                      aws.String(c.Endpoints[STS]),
+		HTTPClient:                    sessionConfig.httpClient,
+		MaxRetries:                    aws.Int(c.MaxRetries),
+		Region:                        aws.String(c.Region),
+	}))
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role with web identity session: %w", err)
+	}
+
+	var tokenFetcher stscreds.TokenFetcher
+
+	if c.AssumeRoleWithWebIdentityToken != "" {
+		tokenFetcher = webIdentityToken(c.AssumeRoleWithWebIdentityToken)
+	} else {
+		tokenFile, err := homedir.Expand(c.AssumeRoleWithWebIdentityTokenFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding web identity token file name: %w", err)
+		}
+
+		tokenFetcher = stscreds.FetchTokenPath(tokenFile)
+	}
+
+	provider := stscreds.NewWebIdentityRoleProviderWithToken(sts.New(sess, c.endpointVariantsConfig()), c.AssumeRoleWithWebIdentityRoleARN, c.AssumeRoleWithWebIdentitySessionName, tokenFetcher)
+
+	if c.AssumeRoleWithWebIdentityDurationSeconds > 0 {
+		provider.Duration = time.Duration(c.AssumeRoleWithWebIdentityDurationSeconds) * time.Second
+	}
+
+	for _, policyARN := range c.AssumeRoleWithWebIdentityPolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	creds := credentials.NewCredentials(provider)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("error assuming role (%s) with web identity: %w", c.AssumeRoleWithWebIdentityRoleARN, err)
+	}
+
+	return creds, nil
+}
@@ -0,0 +1,291 @@
+package conns
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigClientAssumeRoleWithWebIdentity(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := ioutil.WriteFile(tokenFile, []byte(awsbase.MockWebIdentityToken), 0600); err != nil {
+		t.Fatalf("error writing web identity token file: %s", err)
+	}
+
+	testCases := []struct {
+		Name        string
+		Config      *Config
+		StsEndpoint *awsbase.MockEndpoint
+		ExpectError bool
+	}{
+		{
+			Name: "token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+			},
+			StsEndpoint: awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+		},
+		{
+			Name: "token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityTokenFile:   tokenFile,
+			},
+			StsEndpoint: awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+		},
+		{
+			Name: "duration and policy ARNs",
+			Config: &Config{
+				AssumeRoleWithWebIdentityDurationSeconds: 3600,
+				AssumeRoleWithWebIdentityPolicyARNs:      []string{awsbase.MockStsAssumeRolePolicyArn},
+				AssumeRoleWithWebIdentityRoleARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName:     awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:           awsbase.MockWebIdentityToken,
+			},
+			StsEndpoint: testMockStsAssumeRoleWithWebIdentityValidEndpointWithOptions(map[string]string{
+				"DurationSeconds":         "3600",
+				"PolicyArns.member.1.arn": awsbase.MockStsAssumeRolePolicyArn,
+			}),
+		},
+		{
+			Name: "invalid token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       "invalid",
+			},
+			StsEndpoint: awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+			ExpectError: true,
+		},
+		{
+			Name: "missing token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityTokenFile:   filepath.Join(t.TempDir(), "missing"),
+			},
+			StsEndpoint: awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			oldEnv := testUnsetEnv(t)
+			defer testRestoreEnv(t, oldEnv)
+
+			ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+				testCase.StsEndpoint,
+				awsbase.MockStsGetCallerIdentityValidEndpoint,
+			})
+			defer ts.Close()
+
+			config := testCase.Config
+			config.Endpoints = map[string]string{STS: ts.URL}
+			config.MaxRetries = 1
+			config.Region = "us-east-1" //lintignore:AWSAT003
+			config.SkipGetEC2Platforms = true
+			config.SkipMetadataApiCheck = true
+
+			raw, err := config.Client()
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			client := raw.(*AWSClient)
+
+			if got, expected := client.AccountID, awsbase.MockStsGetCallerIdentityAccountID; got != expected {
+				t.Errorf("got account ID %s, expected %s", got, expected)
+			}
+
+			value, err := client.session.Config.Credentials.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error getting credentials: %s", err)
+			}
+
+			if got, expected := value.ProviderName, stscreds.WebIdentityProviderName; got != expected {
+				t.Errorf("got credentials provider %s, expected %s", got, expected)
+			}
+
+			if got, expected := value.AccessKeyID, awsbase.MockStsAssumeRoleWithWebIdentityAccessKey; got != expected {
+				t.Errorf("got access key %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigClientAssumeRoleWithWebIdentityAndAssumeRole(t *testing.T) {
+	oldEnv := testUnsetEnv(t)
+	defer testRestoreEnv(t, oldEnv)
+
+	ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+		awsbase.MockStsAssumeRoleValidEndpoint,
+		awsbase.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	config := &Config{
+		AssumeRoleARN:                        awsbase.MockStsAssumeRoleArn,
+		AssumeRoleSessionName:                awsbase.MockStsAssumeRoleSessionName,
+		AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+		AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+		AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+		Endpoints:                            map[string]string{STS: ts.URL},
+		MaxRetries:                           1,
+		Region:                               "us-east-1", //lintignore:AWSAT003
+		SkipGetEC2Platforms:                  true,
+		SkipMetadataApiCheck:                 true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	value, err := client.session.Config.Credentials.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error getting credentials: %s", err)
+	}
+
+	if got, expected := value.ProviderName, stscreds.ProviderName; got != expected {
+		t.Errorf("got credentials provider %s, expected %s", got, expected)
+	}
+
+	if got, expected := value.AccessKeyID, awsbase.MockStsAssumeRoleAccessKey; got != expected {
+		t.Errorf("got access key %s, expected %s", got, expected)
+	}
+}
+
+func TestConfigClientAssumeRoleWithWebIdentityHTTPProxy(t *testing.T) {
+	oldEnv := testUnsetEnv(t)
+	defer testRestoreEnv(t, oldEnv)
+
+	ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+		awsbase.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	var proxied int
+
+	// The proxy serves requests for any host from the mock STS API.
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied++
+		r.RequestURI = r.URL.RequestURI()
+		ts.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	config := &Config{
+		AssumeRoleWithWebIdentityRoleARN:     awsbase.MockStsAssumeRoleWithWebIdentityArn,
+		AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+		AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+		Endpoints:                            map[string]string{STS: "http://sts.example.invalid"},
+		HTTPProxy:                            proxy.URL,
+		MaxRetries:                           1,
+		Region:                               "us-east-1", //lintignore:AWSAT003
+		SkipGetEC2Platforms:                  true,
+		SkipMetadataApiCheck:                 true,
+	}
+
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// AssumeRoleWithWebIdentity and GetCallerIdentity.
+	if got, expected := proxied, 2; got != expected {
+		t.Errorf("got %d proxied requests, expected %d", got, expected)
+	}
+}
+
+func testMockStsAssumeRoleWithWebIdentityValidEndpointWithOptions(options map[string]string) *awsbase.MockEndpoint {
+	urlValues := url.Values{
+		"Action":           []string{"AssumeRoleWithWebIdentity"},
+		"RoleArn":          []string{awsbase.MockStsAssumeRoleWithWebIdentityArn},
+		"RoleSessionName":  []string{awsbase.MockStsAssumeRoleWithWebIdentitySessionName},
+		"Version":          []string{"2011-06-15"},
+		"WebIdentityToken": []string{awsbase.MockWebIdentityToken},
+	}
+
+	for k, v := range options {
+		urlValues.Set(k, v)
+	}
+
+	return &awsbase.MockEndpoint{
+		Request: &awsbase.MockRequest{
+			Body:   urlValues.Encode(),
+			Method: http.MethodPost,
+			Uri:    "/",
+		},
+		Response: &awsbase.MockResponse{
+			Body:        awsbase.MockStsAssumeRoleWithWebIdentityValidResponseBody,
+			ContentType: "text/xml",
+			StatusCode:  http.StatusOK,
+		},
+	}
+}
+
+// testUnsetEnv unsets any AWS environment variables that could affect credential
+// resolution and returns the previous values.
+func testUnsetEnv(t *testing.T) map[string]string {
+	t.Helper()
+
+	env := make(map[string]string)
+
+	for _, k := range []string{
+		"AWS_ACCESS_KEY_ID",
+		"AWS_CONFIG_FILE",
+		"AWS_PROFILE",
+		"AWS_ROLE_ARN",
+		"AWS_SECRET_ACCESS_KEY",
+		"AWS_SESSION_TOKEN",
+		"AWS_SHARED_CREDENTIALS_FILE",
+		"AWS_WEB_IDENTITY_TOKEN_FILE",
+	} {
+		if v, ok := os.LookupEnv(k); ok {
+			env[k] = v
+		}
+
+		if err := os.Unsetenv(k); err != nil {
+			t.Fatalf("error unsetting environment variable %s: %s", k, err)
+		}
+	}
+
+	return env
+}
+
+func testRestoreEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+
+	for k, v := range env {
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatalf("error restoring environment variable %s: %s", k, err)
+		}
+	}
+}
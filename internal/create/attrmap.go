@@ -10,8 +10,10 @@ import (
 
 // AttributeMap represents a map of Terraform resource attribute name to AWS API attribute name.
 // Useful for SQS Queue or SNS Topic attribute handling.
+// Attributes with an AttributeCodec, e.g. JSON or IAM policy documents, are converted by the codec rather than by Terraform type.
 type attributeInfo struct {
 	apiAttributeName   string
+	codec              *AttributeCodec
 	tfType             schema.ValueType
 	tfOptionalComputed bool
 }
@@ -42,6 +44,18 @@ func AttrMap(attrMap map[string]string, schemaMap map[string]*schema.Schema) Att
 	return attributeMap
 }
 
+// WithCodec returns the AttributeMap with the specified codec used to convert the specified Terraform resource attribute's values.
+func (m AttributeMap) WithCodec(tfAttributeName string, codec AttributeCodec) AttributeMap {
+	if attributeInfo, ok := m[tfAttributeName]; ok {
+		attributeInfo.codec = &codec
+		m[tfAttributeName] = attributeInfo
+	} else {
+		log.Printf("[ERROR] Unknown attribute: %s", tfAttributeName)
+	}
+
+	return m
+}
+
 // ApiAttributesToResourceData sets Terraform ResourceData from a map of AWS API attributes.
 func (m AttributeMap) ApiAttributesToResourceData(apiAttributes map[string]string, d *schema.ResourceData) error {
 	for tfAttributeName, attributeInfo := range m {
@@ -49,23 +63,31 @@ func (m AttributeMap) ApiAttributesToResourceData(apiAttributes map[string]strin
 			var err error
 			var tfAttributeValue interface{}
 
-			switch t := attributeInfo.tfType; t {
-			case schema.TypeBool:
-				tfAttributeValue, err = strconv.ParseBool(v)
+			if codec := attributeInfo.codec; codec != nil {
+				tfAttributeValue, err = codec.Decode(v, d.Get(tfAttributeName))
 
 				if err != nil {
-					return fmt.Errorf("error parsing %s value (%s) into boolean: %w", tfAttributeName, v, err)
+					return fmt.Errorf("error decoding %s value (%s): %w", tfAttributeName, v, err)
 				}
-			case schema.TypeInt:
-				tfAttributeValue, err = strconv.Atoi(v)
-
-				if err != nil {
-					return fmt.Errorf("error parsing %s value (%s) into integer: %w", tfAttributeName, v, err)
+			} else {
+				switch t := attributeInfo.tfType; t {
+				case schema.TypeBool:
+					tfAttributeValue, err = strconv.ParseBool(v)
+
+					if err != nil {
+						return fmt.Errorf("error parsing %s value (%s) into boolean: %w", tfAttributeName, v, err)
+					}
+				case schema.TypeInt:
+					tfAttributeValue, err = strconv.Atoi(v)
+
+					if err != nil {
+						return fmt.Errorf("error parsing %s value (%s) into integer: %w", tfAttributeName, v, err)
+					}
+				case schema.TypeString:
+					tfAttributeValue = v
+				default:
+					return fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
 				}
-			case schema.TypeString:
-				tfAttributeValue = v
-			default:
-				return fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
 			}
 
 			if err := d.Set(tfAttributeName, tfAttributeValue); err != nil {
@@ -87,6 +109,21 @@ func (m AttributeMap) ResourceDataToApiAttributesCreate(d *schema.ResourceData)
 	for tfAttributeName, attributeInfo := range m {
 		var apiAttributeValue string
 
+		if codec := attributeInfo.codec; codec != nil {
+			var err error
+			apiAttributeValue, err = codec.Encode(d.Get(tfAttributeName))
+
+			if err != nil {
+				return nil, fmt.Errorf("error encoding %s: %w", tfAttributeName, err)
+			}
+
+			if apiAttributeValue != "" {
+				apiAttributes[attributeInfo.apiAttributeName] = apiAttributeValue
+			}
+
+			continue
+		}
+
 		switch v, t := d.Get(tfAttributeName), attributeInfo.tfType; t {
 		case schema.TypeBool:
 			if v := v.(bool); v {
@@ -122,6 +159,19 @@ func (m AttributeMap) ResourceDataToApiAttributesUpdate(d *schema.ResourceData)
 
 			var apiAttributeValue string
 
+			if codec := attributeInfo.codec; codec != nil {
+				var err error
+				apiAttributeValue, err = codec.Encode(v)
+
+				if err != nil {
+					return nil, fmt.Errorf("error encoding %s: %w", tfAttributeName, err)
+				}
+
+				apiAttributes[attributeInfo.apiAttributeName] = apiAttributeValue
+
+				continue
+			}
+
 			switch t := attributeInfo.tfType; t {
 			case schema.TypeBool:
 				apiAttributeValue = strconv.FormatBool(v.(bool))
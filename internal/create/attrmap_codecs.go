@@ -0,0 +1,150 @@
+package create
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	awspolicy "github.com/jen20/awspolicyequivalence"
+)
+
+// AttributeCodec converts between a Terraform resource attribute value and the string value of an AWS API attribute.
+type AttributeCodec struct {
+	// Encode returns the AWS API attribute value for a Terraform resource attribute value.
+	// An empty string is not sent on resource create.
+	Encode func(tfAttributeValue interface{}) (string, error)
+
+	// Decode returns the Terraform resource attribute value for an AWS API attribute value.
+	// The current Terraform resource attribute value is passed so that it can be kept when
+	// semantically equivalent to the AWS API attribute value, preventing spurious differences.
+	Decode func(apiAttributeValue string, tfAttributeValue interface{}) (interface{}, error)
+}
+
+// JSONAttributeCodec converts JSON string attributes, normalizing whitespace and key order in both directions.
+// The current value is kept on read when the AWS API returns equivalent JSON.
+var JSONAttributeCodec = AttributeCodec{
+	Encode: func(tfAttributeValue interface{}) (string, error) {
+		return normalizeJSON(tfAttributeValue.(string))
+	},
+	Decode: func(apiAttributeValue string, tfAttributeValue interface{}) (interface{}, error) {
+		apiValue, err := normalizeJSON(apiAttributeValue)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := tfAttributeValue.(string); ok && v != "" && apiValue != "" {
+			if current, err := normalizeJSON(v); err == nil && current == apiValue {
+				return v, nil
+			}
+		}
+
+		return apiValue, nil
+	},
+}
+
+// PolicyAttributeCodec converts IAM policy document attributes.
+// The current value is kept on read when the AWS API returns an equivalent policy.
+var PolicyAttributeCodec = AttributeCodec{
+	Encode: func(tfAttributeValue interface{}) (string, error) {
+		return normalizeJSON(tfAttributeValue.(string))
+	},
+	Decode: func(apiAttributeValue string, tfAttributeValue interface{}) (interface{}, error) {
+		if v, ok := tfAttributeValue.(string); ok && v != "" && apiAttributeValue != "" {
+			if equivalent, err := awspolicy.PoliciesAreEquivalent(v, apiAttributeValue); err == nil && equivalent {
+				return v, nil
+			}
+		}
+
+		return normalizeJSON(apiAttributeValue)
+	},
+}
+
+// DurationSecondsAttributeCodec converts duration string attributes, e.g. "5m", to and from AWS API attributes in seconds.
+// The current value is kept on read when the AWS API returns the same number of seconds.
+var DurationSecondsAttributeCodec = AttributeCodec{
+	Encode: func(tfAttributeValue interface{}) (string, error) {
+		v := tfAttributeValue.(string)
+
+		if v == "" {
+			return "", nil
+		}
+
+		duration, err := time.ParseDuration(v)
+
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatInt(int64(duration/time.Second), 10), nil
+	},
+	Decode: func(apiAttributeValue string, tfAttributeValue interface{}) (interface{}, error) {
+		if apiAttributeValue == "" {
+			return "", nil
+		}
+
+		seconds, err := strconv.ParseInt(apiAttributeValue, 10, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing seconds: %w", err)
+		}
+
+		duration := time.Duration(seconds) * time.Second
+
+		if v, ok := tfAttributeValue.(string); ok && v != "" {
+			if current, err := time.ParseDuration(v); err == nil && current == duration {
+				return v, nil
+			}
+		}
+
+		return duration.String(), nil
+	},
+}
+
+// CommaListAttributeCodec converts list or set of string attributes to and from comma separated AWS API attributes.
+var CommaListAttributeCodec = AttributeCodec{
+	Encode: func(tfAttributeValue interface{}) (string, error) {
+		var tfList []interface{}
+
+		switch v := tfAttributeValue.(type) {
+		case []interface{}:
+			tfList = v
+		case *schema.Set:
+			tfList = v.List()
+		default:
+			return "", fmt.Errorf("unsupported type: %T", tfAttributeValue)
+		}
+
+		apiValues := make([]string, 0, len(tfList))
+
+		for _, tfValue := range tfList {
+			if v, ok := tfValue.(string); ok && v != "" {
+				apiValues = append(apiValues, v)
+			}
+		}
+
+		return strings.Join(apiValues, ","), nil
+	},
+	Decode: func(apiAttributeValue string, _ interface{}) (interface{}, error) {
+		tfList := []interface{}{}
+
+		for _, v := range strings.Split(apiAttributeValue, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				tfList = append(tfList, v)
+			}
+		}
+
+		return tfList, nil
+	},
+}
+
+func normalizeJSON(v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+
+	return structure.NormalizeJsonString(v)
+}
@@ -0,0 +1,258 @@
+package create
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testAttributeMapSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"delay_seconds": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"fifo_queue": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"redrive_policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"retention": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"subnets": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func testAttributeMap(schemaMap map[string]*schema.Schema) AttributeMap {
+	return AttrMap(map[string]string{
+		"delay_seconds":  "DelaySeconds",
+		"fifo_queue":     "FifoQueue",
+		"policy":         "Policy",
+		"redrive_policy": "RedrivePolicy",
+		"retention":      "MessageRetentionPeriod",
+		"subnets":        "Subnets",
+	}, schemaMap).
+		WithCodec("policy", PolicyAttributeCodec).
+		WithCodec("redrive_policy", JSONAttributeCodec).
+		WithCodec("retention", DurationSecondsAttributeCodec).
+		WithCodec("subnets", CommaListAttributeCodec)
+}
+
+func TestAttributeMapResourceDataToApiAttributesCreate(t *testing.T) {
+	schemaMap := testAttributeMapSchema()
+	d := schema.TestResourceDataRaw(t, schemaMap, map[string]interface{}{
+		"delay_seconds":  10,
+		"policy":         `{"Version": "2012-10-17", "Statement": []}`,
+		"redrive_policy": `{ "maxReceiveCount": 5, "deadLetterTargetArn": "arn" }`,
+		"retention":      "1h30m",
+		"subnets":        []interface{}{"subnet-1"},
+	})
+
+	got, err := testAttributeMap(schemaMap).ResourceDataToApiAttributesCreate(d)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"DelaySeconds":           "10",
+		"MessageRetentionPeriod": "5400",
+		"Policy":                 `{"Statement":[],"Version":"2012-10-17"}`,
+		"RedrivePolicy":          `{"deadLetterTargetArn":"arn","maxReceiveCount":5}`,
+		"Subnets":                "subnet-1",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got %#v, expected %#v", got, expected)
+	}
+}
+
+func TestAttributeMapApiAttributesToResourceData(t *testing.T) {
+	schemaMap := testAttributeMapSchema()
+	d := schema.TestResourceDataRaw(t, schemaMap, map[string]interface{}{
+		"policy":    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:SendMessage"],"Resource":"*"}]}`,
+		"retention": "90m",
+	})
+
+	err := testAttributeMap(schemaMap).ApiAttributesToResourceData(map[string]string{
+		"DelaySeconds":           "10",
+		"FifoQueue":              "true",
+		"MessageRetentionPeriod": "5400",
+		"Policy":                 `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`,
+		"RedrivePolicy":          `{ "maxReceiveCount": 5, "deadLetterTargetArn": "arn" }`,
+		"Subnets":                "subnet-1, subnet-2",
+	}, d)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for tfAttributeName, expected := range map[string]interface{}{
+		"delay_seconds": 10,
+		"fifo_queue":    true,
+		// Equivalent policy keeps the configured value.
+		"policy":         `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:SendMessage"],"Resource":"*"}]}`,
+		"redrive_policy": `{"deadLetterTargetArn":"arn","maxReceiveCount":5}`,
+		// Same number of seconds keeps the configured value.
+		"retention": "90m",
+	} {
+		if got := d.Get(tfAttributeName); got != expected {
+			t.Errorf("got %s %#v, expected %#v", tfAttributeName, got, expected)
+		}
+	}
+
+	if got, expected := d.Get("subnets").(*schema.Set).Len(), 2; got != expected {
+		t.Errorf("got %d subnets, expected %d", got, expected)
+	}
+}
+
+func TestAttributeMapResourceDataToApiAttributesUpdate(t *testing.T) {
+	schemaMap := testAttributeMapSchema()
+	d := schema.TestResourceDataRaw(t, schemaMap, map[string]interface{}{
+		"retention": "2m",
+	})
+
+	got, err := testAttributeMap(schemaMap).ResourceDataToApiAttributesUpdate(d)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := got["MessageRetentionPeriod"], "120"; got != expected {
+		t.Errorf("got MessageRetentionPeriod %q, expected %q", got, expected)
+	}
+
+	if _, ok := got["Policy"]; ok {
+		t.Errorf("unexpected Policy: %q", got["Policy"])
+	}
+}
+
+func TestJSONAttributeCodecDecode(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		APIAttributeValue string
+		TFAttributeValue  interface{}
+		Expected          interface{}
+	}{
+		{
+			TestName:          "no current value",
+			APIAttributeValue: `{ "maxReceiveCount": 5, "deadLetterTargetArn": "arn" }`,
+			TFAttributeValue:  "",
+			Expected:          `{"deadLetterTargetArn":"arn","maxReceiveCount":5}`,
+		},
+		{
+			TestName:          "equivalent",
+			APIAttributeValue: `{"deadLetterTargetArn":"arn","maxReceiveCount":5}`,
+			TFAttributeValue:  "{\n  \"maxReceiveCount\": 5,\n  \"deadLetterTargetArn\": \"arn\"\n}\n",
+			Expected:          "{\n  \"maxReceiveCount\": 5,\n  \"deadLetterTargetArn\": \"arn\"\n}\n",
+		},
+		{
+			TestName:          "not equivalent",
+			APIAttributeValue: `{"deadLetterTargetArn":"arn","maxReceiveCount":10}`,
+			TFAttributeValue:  "{\n  \"maxReceiveCount\": 5,\n  \"deadLetterTargetArn\": \"arn\"\n}\n",
+			Expected:          `{"deadLetterTargetArn":"arn","maxReceiveCount":10}`,
+		},
+		{
+			TestName:          "empty",
+			APIAttributeValue: "",
+			TFAttributeValue:  `{"maxReceiveCount":5}`,
+			Expected:          "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := JSONAttributeCodec.Decode(testCase.APIAttributeValue, testCase.TFAttributeValue)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPolicyAttributeCodecDecode(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		APIAttributeValue string
+		TFAttributeValue  interface{}
+		Expected          interface{}
+	}{
+		{
+			TestName:          "no current value",
+			APIAttributeValue: `{ "Version": "2012-10-17", "Statement": [] }`,
+			TFAttributeValue:  "",
+			Expected:          `{"Statement":[],"Version":"2012-10-17"}`,
+		},
+		{
+			TestName:          "equivalent",
+			APIAttributeValue: `{"Statement":[{"Action":"sqs:*","Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`,
+			TFAttributeValue:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:*"],"Resource":"*"}]}`,
+			Expected:          `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:*"],"Resource":"*"}]}`,
+		},
+		{
+			TestName:          "not equivalent",
+			APIAttributeValue: `{"Statement":[{"Action":"sqs:*","Effect":"Deny","Resource":"*"}],"Version":"2012-10-17"}`,
+			TFAttributeValue:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:*"],"Resource":"*"}]}`,
+			Expected:          `{"Statement":[{"Action":"sqs:*","Effect":"Deny","Resource":"*"}],"Version":"2012-10-17"}`,
+		},
+		{
+			TestName:          "empty",
+			APIAttributeValue: "",
+			TFAttributeValue:  `{"Version":"2012-10-17","Statement":[]}`,
+			Expected:          "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := PolicyAttributeCodec.Decode(testCase.APIAttributeValue, testCase.TFAttributeValue)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDurationSecondsAttributeCodec(t *testing.T) {
+	if _, err := DurationSecondsAttributeCodec.Encode("five minutes"); err == nil {
+		t.Error("expected error encoding invalid duration")
+	}
+
+	got, err := DurationSecondsAttributeCodec.Decode("300", "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "5m0s"; got != expected {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+
+	if _, err := DurationSecondsAttributeCodec.Decode("abc", ""); err == nil {
+		t.Error("expected error decoding invalid seconds")
+	}
+}
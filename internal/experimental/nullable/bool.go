@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,6 +30,17 @@ func (b Bool) Value() (bool, bool, error) {
 	return value, false, nil
 }
 
+// Pointer returns the value as an AWS SDK *bool, or nil if the value is null or invalid.
+// An invalid value cannot be told apart from null, so callers must validate the value first,
+// e.g. with ValidateTypeStringNullableBool in the schema.
+func (b Bool) Pointer() *bool {
+	value, null, err := b.Value()
+	if null || err != nil {
+		return nil
+	}
+	return aws.Bool(value)
+}
+
 func NewBool(v bool) Bool {
 	return Bool(strconv.FormatBool(v))
 }
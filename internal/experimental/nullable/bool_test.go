@@ -13,6 +13,7 @@ func TestNullableBool(t *testing.T) {
 		expectNull    bool
 		expectedValue bool
 		expectedErr   error
+		expectNilPtr  bool
 	}{
 		{
 			val:           "true",
@@ -27,6 +28,7 @@ func TestNullableBool(t *testing.T) {
 		{
 			val:           "",
 			expectNull:    true,
+			expectNilPtr:  true,
 			expectedValue: false,
 		},
 		{
@@ -34,6 +36,7 @@ func TestNullableBool(t *testing.T) {
 			expectNull:    false,
 			expectedValue: false,
 			expectedErr:   strconv.ErrSyntax,
+			expectNilPtr:  true,
 		},
 	}
 
@@ -59,6 +62,20 @@ func TestNullableBool(t *testing.T) {
 				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
 			}
 		}
+
+		ptr := v.Pointer()
+		if tc.expectNilPtr {
+			if ptr != nil {
+				t.Fatalf("expected test case %d Pointer to be nil, got %t", i, *ptr)
+			}
+		} else {
+			if ptr == nil {
+				t.Fatalf("expected test case %d Pointer to be %t, got nil", i, tc.expectedValue)
+			}
+			if *ptr != tc.expectedValue {
+				t.Fatalf("expected test case %d Pointer to be %t, got %t", i, tc.expectedValue, *ptr)
+			}
+		}
 	}
 }
 
@@ -0,0 +1,138 @@
+package nullable
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableDuration = schema.TypeString
+)
+
+// Duration is a duration in either Go duration format, e.g. "1h30m", or ISO 8601 duration format, e.g. "PT1H30M".
+type Duration string
+
+func (d Duration) IsNull() bool {
+	return d == ""
+}
+
+func (d Duration) Value() (time.Duration, bool, error) {
+	if d.IsNull() {
+		return 0, true, nil
+	}
+
+	value, err := parseDuration(string(d))
+	if err != nil {
+		return 0, false, err
+	}
+	return value, false, nil
+}
+
+// SecondsPointer returns the value in whole seconds as an AWS SDK *int64, or nil if the value is null or invalid.
+func (d Duration) SecondsPointer() *int64 {
+	value, null, err := d.Value()
+	if null || err != nil {
+		return nil
+	}
+	return aws.Int64(int64(value / time.Second))
+}
+
+func NewDuration(v time.Duration) Duration {
+	return Duration(v.String())
+}
+
+// ValidateTypeStringNullableDuration provides custom error messaging for TypeString durations
+// Some arguments require a duration value or an unspecified, empty field.
+func ValidateTypeStringNullableDuration(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	if _, err := parseDuration(value); err != nil {
+		es = append(es, fmt.Errorf("%s: cannot parse '%s' as duration: %w", k, value, err))
+	}
+
+	return
+}
+
+// ValidateTypeStringNullableDurationBetween provides custom error messaging for TypeString durations
+// Some arguments require a duration value or an unspecified, empty field.
+func ValidateTypeStringNullableDurationBetween(min time.Duration, max time.Duration) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		v, err := parseDuration(value)
+		if err != nil {
+			es = append(es, fmt.Errorf("%s: cannot parse '%s' as duration: %w", k, value, err))
+			return
+		}
+
+		if v < min || v > max {
+			es = append(es, fmt.Errorf("expected %s to be in the range (%s - %s), got %s", k, min, max, v))
+		}
+
+		return
+	}
+}
+
+var (
+	// ErrDurationCalendarUnits is returned for ISO 8601 durations with years or months, which have no fixed length.
+	ErrDurationCalendarUnits = errors.New("ISO 8601 durations with years or months are not supported")
+
+	iso8601DurationRegexp = regexp.MustCompile(`^P(?:(\d+(?:\.\d+)?)Y)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)W)?(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+)
+
+// parseDuration parses a duration in ISO 8601 format if it starts with "P", otherwise in Go duration format.
+func parseDuration(s string) (time.Duration, error) {
+	if len(s) == 0 || s[0] != 'P' {
+		return time.ParseDuration(s)
+	}
+
+	m := iso8601DurationRegexp.FindStringSubmatch(s)
+
+	// The regexp also matches "P" and durations ending in an empty time part, e.g. "P1DT".
+	if m == nil || s == "P" || s[len(s)-1] == 'T' {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+	}
+
+	if m[1] != "" || m[2] != "" {
+		return 0, ErrDurationCalendarUnits
+	}
+
+	var d time.Duration
+
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if m[i+3] == "" {
+			continue
+		}
+
+		v, err := strconv.ParseFloat(m[i+3], 64)
+		if err != nil {
+			return 0, err
+		}
+
+		d += time.Duration(v * float64(unit))
+	}
+
+	return d, nil
+}
@@ -0,0 +1,143 @@
+package nullable
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestNullableDuration(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectNull    bool
+		expectedValue time.Duration
+		expectedErr   error
+	}{
+		{
+			val:           "1h30m",
+			expectNull:    false,
+			expectedValue: 90 * time.Minute,
+		},
+		{
+			val:           "0s",
+			expectNull:    false,
+			expectedValue: 0,
+		},
+		{
+			val:           "PT1H30M",
+			expectNull:    false,
+			expectedValue: 90 * time.Minute,
+		},
+		{
+			val:           "P1DT12H",
+			expectNull:    false,
+			expectedValue: 36 * time.Hour,
+		},
+		{
+			val:           "P2W",
+			expectNull:    false,
+			expectedValue: 14 * 24 * time.Hour,
+		},
+		{
+			val:           "PT0.5S",
+			expectNull:    false,
+			expectedValue: 500 * time.Millisecond,
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: 0,
+		},
+		{
+			val:           "P1M",
+			expectNull:    false,
+			expectedValue: 0,
+			expectedErr:   ErrDurationCalendarUnits,
+		},
+	}
+
+	for i, tc := range cases {
+		v := Duration(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectNull)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %s, got %s", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if tc.expectedErr == nil && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectedErr != nil {
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
+			}
+		}
+	}
+}
+
+func TestNullableDurationInvalid(t *testing.T) {
+	for _, val := range []string{"A", "P", "PT", "P1DT", "P1H", "PT1D", "1d"} {
+		if _, _, err := Duration(val).Value(); err == nil {
+			t.Fatalf("expected %q to fail to parse", val)
+		}
+	}
+}
+
+func TestNullableDurationSecondsPointer(t *testing.T) {
+	if p := Duration("PT1M30S").SecondsPointer(); p == nil || *p != 90 {
+		t.Fatalf("expected 90 seconds, got %v", p)
+	}
+
+	if p := Duration("").SecondsPointer(); p != nil {
+		t.Fatalf("expected nil, got %d", *p)
+	}
+}
+
+func TestValidationDuration(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "5m",
+			f:   ValidateTypeStringNullableDuration,
+		},
+		{
+			val: "PT5M",
+			f:   ValidateTypeStringNullableDuration,
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as duration: .*`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationDurationBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "PT1H",
+			f:   ValidateTypeStringNullableDurationBetween(time.Minute, time.Hour),
+		},
+		{
+			val:         "2h",
+			f:           ValidateTypeStringNullableDurationBetween(time.Minute, time.Hour),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be in the range \(1m0s - 1h0m0s\), got 2h0m0s`),
+		},
+		{
+			val:         "P1Y",
+			f:           ValidateTypeStringNullableDurationBetween(time.Minute, time.Hour),
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'P1Y' as duration: .*`),
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,6 +30,17 @@ func (i Int) Value() (int64, bool, error) {
 	return value, false, nil
 }
 
+// Pointer returns the value as an AWS SDK *int64, or nil if the value is null or invalid.
+// An invalid value cannot be told apart from null, so callers must validate the value first,
+// e.g. with ValidateTypeStringNullableInt in the schema.
+func (i Int) Pointer() *int64 {
+	value, null, err := i.Value()
+	if null || err != nil {
+		return nil
+	}
+	return aws.Int64(value)
+}
+
 // ValidateTypeStringNullableInt provides custom error messaging for TypeString ints
 // Some arguments require an int value or unspecified, empty field.
 func ValidateTypeStringNullableInt(v interface{}, k string) (ws []string, es []error) {
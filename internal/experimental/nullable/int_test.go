@@ -13,6 +13,7 @@ func TestNullableInt(t *testing.T) {
 		expectNull    bool
 		expectedValue int64
 		expectedErr   error
+		expectNilPtr  bool
 	}{
 		{
 			val:           "1",
@@ -22,6 +23,7 @@ func TestNullableInt(t *testing.T) {
 		{
 			val:           "",
 			expectNull:    true,
+			expectNilPtr:  true,
 			expectedValue: 0,
 		},
 		{
@@ -29,6 +31,7 @@ func TestNullableInt(t *testing.T) {
 			expectNull:    false,
 			expectedValue: 0,
 			expectedErr:   strconv.ErrSyntax,
+			expectNilPtr:  true,
 		},
 	}
 
@@ -54,6 +57,20 @@ func TestNullableInt(t *testing.T) {
 				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
 			}
 		}
+
+		ptr := v.Pointer()
+		if tc.expectNilPtr {
+			if ptr != nil {
+				t.Fatalf("expected test case %d Pointer to be nil, got %d", i, *ptr)
+			}
+		} else {
+			if ptr == nil {
+				t.Fatalf("expected test case %d Pointer to be %d, got nil", i, tc.expectedValue)
+			}
+			if *ptr != tc.expectedValue {
+				t.Fatalf("expected test case %d Pointer to be %d, got %d", i, tc.expectedValue, *ptr)
+			}
+		}
 	}
 }
 
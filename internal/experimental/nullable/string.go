@@ -0,0 +1,87 @@
+package nullable
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableString = schema.TypeString
+)
+
+// String is a string for which the empty string means unset.
+type String string
+
+func (s String) IsNull() bool {
+	return s == ""
+}
+
+func (s String) Value() (string, bool, error) {
+	if s.IsNull() {
+		return "", true, nil
+	}
+
+	return string(s), false, nil
+}
+
+// Pointer returns the value as an AWS SDK *string, or nil if the value is null.
+func (s String) Pointer() *string {
+	if s.IsNull() {
+		return nil
+	}
+	return aws.String(string(s))
+}
+
+func NewString(v string) String {
+	return String(v)
+}
+
+// ValidateTypeStringNullableStringLenBetween provides custom error messaging for TypeString strings
+// Some arguments require a string of a given length or an unspecified, empty field.
+func ValidateTypeStringNullableStringLenBetween(min int, max int) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		if len(value) < min || len(value) > max {
+			es = append(es, fmt.Errorf("expected length of %s to be in the range (%d - %d), got %s", k, min, max, value))
+		}
+
+		return
+	}
+}
+
+// ValidateTypeStringNullableStringInSlice provides custom error messaging for TypeString strings
+// Some arguments require one of a set of values or an unspecified, empty field.
+func ValidateTypeStringNullableStringInSlice(valid []string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		for _, str := range valid {
+			if value == str {
+				return
+			}
+		}
+
+		es = append(es, fmt.Errorf("expected %s to be one of %v, got %s", k, valid, value))
+
+		return
+	}
+}
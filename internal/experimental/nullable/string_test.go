@@ -0,0 +1,86 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestNullableString(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectNull    bool
+		expectedValue string
+	}{
+		{
+			val:           "example",
+			expectNull:    false,
+			expectedValue: "example",
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: "",
+		},
+	}
+
+	for i, tc := range cases {
+		v := String(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectNull)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %q, got %q", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+
+		if p := v.Pointer(); (p == nil) != tc.expectNull || (p != nil && *p != tc.expectedValue) {
+			t.Fatalf("expected test case %d Pointer to match Value, got %v", i, p)
+		}
+	}
+}
+
+func TestValidationStringLenBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "abc",
+			f:   ValidateTypeStringNullableStringLenBetween(1, 3),
+		},
+		{
+			val:         "abcd",
+			f:           ValidateTypeStringNullableStringLenBetween(1, 3),
+			expectedErr: regexp.MustCompile(`expected length of [\w]+ to be in the range \(1 - 3\), got abcd`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableStringLenBetween(1, 3),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationStringInSlice(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "a",
+			f:   ValidateTypeStringNullableStringInSlice([]string{"a", "b"}),
+		},
+		{
+			val:         "c",
+			f:           ValidateTypeStringNullableStringInSlice([]string{"a", "b"}),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be one of \[a b\], got c`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableStringInSlice([]string{"a", "b"}),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
@@ -0,0 +1,514 @@
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Options controls how Expand and Flatten map Terraform attributes to AWS SDK
+// struct fields.
+type Options struct {
+	// fieldNames maps Terraform attribute names to AWS SDK field names.
+	fieldNames map[string]string
+
+	// attributeNames maps AWS SDK field names to Terraform attribute names.
+	attributeNames map[string]string
+
+	// ignored contains Terraform attribute names that are not mapped.
+	ignored map[string]bool
+
+	// noOmitEmpty contains Terraform attribute names whose zero values are
+	// expanded.
+	noOmitEmpty map[string]bool
+}
+
+// WithFieldName maps a Terraform attribute to an AWS SDK field whose name does
+// not match the attribute name, at any level of nesting, e.g. security_groups
+// to SecurityGroupIds.
+func WithFieldName(attributeName, fieldName string) func(*Options) {
+	return func(o *Options) {
+		o.fieldNames[attributeName] = fieldName
+		o.attributeNames[fieldName] = attributeName
+	}
+}
+
+// WithIgnoredAttribute excludes Terraform attributes, and AWS SDK fields of the
+// same name, at any level of nesting, e.g. attributes handled separately such
+// as tags.
+func WithIgnoredAttribute(attributeNames ...string) func(*Options) {
+	return func(o *Options) {
+		for _, v := range attributeNames {
+			o.ignored[v] = true
+		}
+	}
+}
+
+// WithNoOmitEmpty expands the zero value of Terraform attributes, at any level
+// of nesting, instead of leaving the AWS SDK field nil. This is needed for
+// fields such as booleans whose zero value differs from the API default.
+func WithNoOmitEmpty(attributeNames ...string) func(*Options) {
+	return func(o *Options) {
+		for _, v := range attributeNames {
+			o.noOmitEmpty[v] = true
+		}
+	}
+}
+
+func newOptions(optFns []func(*Options)) *Options {
+	o := &Options{
+		attributeNames: make(map[string]string),
+		fieldNames:     make(map[string]string),
+		ignored:        make(map[string]bool),
+		noOmitEmpty:    make(map[string]bool),
+	}
+
+	for _, optFn := range optFns {
+		optFn(o)
+	}
+
+	return o
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Expand sets the fields of the AWS SDK struct pointed to by apiObject from a
+// Terraform configuration block. tfObject may be the map of the block's
+// attributes or a list, such as the value of a schema.TypeList with MaxItems 1,
+// whose first element is that map.
+//
+// Attributes are mapped to the field whose name matches when underscores are
+// removed and case is ignored, e.g. vpc_id to VpcId. By default zero values,
+// which are indistinguishable from unset attributes, leave the field nil.
+// Nested blocks are expanded into nested structs, lists and sets into slices,
+// and RFC 3339 strings into times. Enum fields are strings.
+func Expand(tfObject interface{}, apiObject interface{}, optFns ...func(*Options)) error {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expanding: target must be a non-nil pointer to a struct, got %T", apiObject)
+	}
+
+	tfMap, ok := blockMap(tfObject)
+
+	if !ok {
+		return nil
+	}
+
+	return expandStruct(newOptions(optFns), "", tfMap, v.Elem())
+}
+
+// Flatten returns a Terraform configuration block from an AWS SDK struct, or
+// pointer to one, as a list whose only element is the map of the block's
+// attributes. A nil pointer is flattened to an empty list.
+//
+// Field names are mapped to snake_case attribute names, e.g. VpcId to vpc_id.
+// Nil fields are omitted. Nested structs are flattened into nested blocks and
+// times into RFC 3339 strings.
+func Flatten(apiObject interface{}, optFns ...func(*Options)) []interface{} {
+	v := reflect.ValueOf(apiObject)
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return []interface{}{}
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return []interface{}{}
+	}
+
+	return []interface{}{flattenStruct(newOptions(optFns), v)}
+}
+
+// blockMap returns the attributes of a Terraform configuration block.
+func blockMap(tfObject interface{}) (map[string]interface{}, bool) {
+	switch v := tfObject.(type) {
+	case map[string]interface{}:
+		return v, true
+	case []interface{}:
+		if len(v) == 0 || v[0] == nil {
+			return nil, false
+		}
+
+		tfMap, ok := v[0].(map[string]interface{})
+
+		return tfMap, ok
+	case *schema.Set:
+		return blockMap(v.List())
+	default:
+		return nil, false
+	}
+}
+
+func expandStruct(o *Options, path string, tfMap map[string]interface{}, v reflect.Value) error {
+	for attributeName, tfValue := range tfMap {
+		if o.ignored[attributeName] {
+			continue
+		}
+
+		field, ok := structField(o, v, attributeName)
+
+		if !ok {
+			continue
+		}
+
+		attributePath := attributeName
+
+		if path != "" {
+			attributePath = path + "." + attributeName
+		}
+
+		if isZero(tfValue) && !o.noOmitEmpty[attributeName] {
+			continue
+		}
+
+		if err := expandValue(o, attributePath, tfValue, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// structField returns the field of the struct to which a Terraform attribute
+// maps.
+func structField(o *Options, v reflect.Value, attributeName string) (reflect.Value, bool) {
+	if fieldName, ok := o.fieldNames[attributeName]; ok {
+		field := v.FieldByName(fieldName)
+
+		return field, field.IsValid() && field.CanSet()
+	}
+
+	name := normalizeName(attributeName)
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if _, ok := o.attributeNames[field.Name]; ok {
+			continue
+		}
+
+		if normalizeName(field.Name) == name {
+			return v.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func expandValue(o *Options, path string, tfValue interface{}, v reflect.Value) error {
+	t := v.Type()
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		if t.Elem().Kind() == reflect.Struct && t.Elem() != timeType {
+			tfMap, ok := blockMap(tfValue)
+
+			if !ok {
+				return fmt.Errorf("expanding %s: expected a configuration block, got %T", path, tfValue)
+			}
+
+			elem := reflect.New(t.Elem())
+
+			if err := expandStruct(o, path, tfMap, elem.Elem()); err != nil {
+				return err
+			}
+
+			v.Set(elem)
+
+			return nil
+		}
+
+		elem := reflect.New(t.Elem())
+
+		if err := expandValue(o, path, tfValue, elem.Elem()); err != nil {
+			return err
+		}
+
+		v.Set(elem)
+
+	case reflect.Slice:
+		var tfList []interface{}
+
+		switch tfValue := tfValue.(type) {
+		case []interface{}:
+			tfList = tfValue
+		case *schema.Set:
+			tfList = tfValue.List()
+		default:
+			return fmt.Errorf("expanding %s: expected a list or set, got %T", path, tfValue)
+		}
+
+		s := reflect.MakeSlice(t, 0, len(tfList))
+
+		for i, tfElem := range tfList {
+			elem := reflect.New(t.Elem()).Elem()
+
+			if err := expandValue(o, fmt.Sprintf("%s.%d", path, i), tfElem, elem); err != nil {
+				return err
+			}
+
+			s = reflect.Append(s, elem)
+		}
+
+		v.Set(s)
+
+	case reflect.Map:
+		tfMap, ok := tfValue.(map[string]interface{})
+
+		if !ok || t.Key().Kind() != reflect.String {
+			return fmt.Errorf("expanding %s: expected a map, got %T", path, tfValue)
+		}
+
+		m := reflect.MakeMapWithSize(t, len(tfMap))
+
+		for k, tfElem := range tfMap {
+			elem := reflect.New(t.Elem()).Elem()
+
+			if err := expandValue(o, path+"."+k, tfElem, elem); err != nil {
+				return err
+			}
+
+			m.SetMapIndex(reflect.ValueOf(k).Convert(t.Key()), elem)
+		}
+
+		v.Set(m)
+
+	case reflect.Struct:
+		if t != timeType {
+			tfMap, ok := blockMap(tfValue)
+
+			if !ok {
+				return fmt.Errorf("expanding %s: expected a configuration block, got %T", path, tfValue)
+			}
+
+			return expandStruct(o, path, tfMap, v)
+		}
+
+		s, ok := tfValue.(string)
+
+		if !ok {
+			return fmt.Errorf("expanding %s: expected an RFC 3339 time string, got %T", path, tfValue)
+		}
+
+		tm, err := time.Parse(time.RFC3339, s)
+
+		if err != nil {
+			return fmt.Errorf("expanding %s: %w", path, err)
+		}
+
+		v.Set(reflect.ValueOf(tm))
+
+	case reflect.String:
+		s, ok := tfValue.(string)
+
+		if !ok {
+			return fmt.Errorf("expanding %s: expected a string, got %T", path, tfValue)
+		}
+
+		v.SetString(s)
+
+	case reflect.Bool:
+		b, ok := tfValue.(bool)
+
+		if !ok {
+			return fmt.Errorf("expanding %s: expected a bool, got %T", path, tfValue)
+		}
+
+		v.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch n := tfValue.(type) {
+		case int:
+			v.SetInt(int64(n))
+		case int64:
+			v.SetInt(n)
+		default:
+			return fmt.Errorf("expanding %s: expected an int, got %T", path, tfValue)
+		}
+
+	case reflect.Float32, reflect.Float64:
+		switch n := tfValue.(type) {
+		case float64:
+			v.SetFloat(n)
+		case int:
+			v.SetFloat(float64(n))
+		default:
+			return fmt.Errorf("expanding %s: expected a float, got %T", path, tfValue)
+		}
+
+	default:
+		return fmt.Errorf("expanding %s: unsupported field type %s", path, t)
+	}
+
+	return nil
+}
+
+func flattenStruct(o *Options, v reflect.Value) map[string]interface{} {
+	tfMap := make(map[string]interface{})
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		attributeName, ok := o.attributeNames[field.Name]
+
+		if !ok {
+			attributeName = snakeCase(field.Name)
+		}
+
+		if o.ignored[attributeName] {
+			continue
+		}
+
+		if tfValue, ok := flattenValue(o, v.Field(i)); ok {
+			tfMap[attributeName] = tfValue
+		}
+	}
+
+	return tfMap
+}
+
+func flattenValue(o *Options, v reflect.Value) (interface{}, bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, false
+		}
+
+		if v.Elem().Kind() == reflect.Struct && v.Elem().Type() != timeType {
+			return []interface{}{flattenStruct(o, v.Elem())}, true
+		}
+
+		return flattenValue(o, v.Elem())
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, false
+		}
+
+		tfList := make([]interface{}, 0, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			elem := v.Index(i)
+
+			if elem.Kind() == reflect.Ptr && !elem.IsNil() && elem.Elem().Kind() == reflect.Struct && elem.Elem().Type() != timeType {
+				tfList = append(tfList, flattenStruct(o, elem.Elem()))
+				continue
+			}
+
+			if tfElem, ok := flattenValue(o, elem); ok {
+				tfList = append(tfList, tfElem)
+			}
+		}
+
+		return tfList, true
+
+	case reflect.Map:
+		if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+
+		tfMap := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+
+		for iter.Next() {
+			if tfElem, ok := flattenValue(o, iter.Value()); ok {
+				tfMap[iter.Key().String()] = tfElem
+			}
+		}
+
+		return tfMap, true
+
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).Format(time.RFC3339), true
+		}
+
+		return []interface{}{flattenStruct(o, v)}, true
+
+	case reflect.String:
+		return v.String(), true
+
+	case reflect.Bool:
+		return v.Bool(), true
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), true
+
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+
+	default:
+		return nil, false
+	}
+}
+
+// isZero returns whether a Terraform attribute value is the zero value of its
+// type, which the Terraform Plugin SDK also returns for unset attributes.
+func isZero(tfValue interface{}) bool {
+	switch v := tfValue.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	default:
+		return false
+	}
+}
+
+// normalizeName returns a name with underscores removed in lower case, so that
+// Terraform attribute and AWS SDK field names can be compared.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// snakeCase returns the snake_case form of an AWS SDK field name, keeping
+// acronyms and numbers together with the preceding word, e.g. DBInstanceArn to
+// db_instance_arn and Ipv6CidrBlock to ipv6_cidr_block.
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				b.WriteRune('_')
+			}
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
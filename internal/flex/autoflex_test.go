@@ -0,0 +1,425 @@
+package flex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpand(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		TFObject interface{}
+		Options  []func(*Options)
+		Target   interface{}
+		Expected interface{}
+	}{
+		{
+			Name: "scalars and enum",
+			TFObject: map[string]interface{}{
+				"amazon_provided_ipv6_cidr_block": true,
+				"cidr_block":                      "10.0.0.0/16",
+				"id":                              "vpc-12345678",
+				"instance_tenancy":                ec2.TenancyDedicated,
+			},
+			Target: &ec2.CreateVpcInput{},
+			Expected: &ec2.CreateVpcInput{
+				AmazonProvidedIpv6CidrBlock: aws.Bool(true),
+				CidrBlock:                   aws.String("10.0.0.0/16"),
+				InstanceTenancy:             aws.String(ec2.TenancyDedicated),
+			},
+		},
+		{
+			Name: "omit empty",
+			TFObject: map[string]interface{}{
+				"amazon_provided_ipv6_cidr_block": false,
+				"cidr_block":                      "10.0.0.0/16",
+				"instance_tenancy":                "",
+			},
+			Target: &ec2.CreateVpcInput{},
+			Expected: &ec2.CreateVpcInput{
+				CidrBlock: aws.String("10.0.0.0/16"),
+			},
+		},
+		{
+			Name: "no omit empty",
+			TFObject: map[string]interface{}{
+				"amazon_provided_ipv6_cidr_block": false,
+				"cidr_block":                      "10.0.0.0/16",
+				"instance_tenancy":                "",
+			},
+			Options: []func(*Options){WithNoOmitEmpty("amazon_provided_ipv6_cidr_block")},
+			Target:  &ec2.CreateVpcInput{},
+			Expected: &ec2.CreateVpcInput{
+				AmazonProvidedIpv6CidrBlock: aws.Bool(false),
+				CidrBlock:                   aws.String("10.0.0.0/16"),
+			},
+		},
+		{
+			Name:     "empty list",
+			TFObject: []interface{}{},
+			Target:   &ecs.DeploymentConfiguration{},
+			Expected: &ecs.DeploymentConfiguration{},
+		},
+		{
+			Name: "nested block",
+			TFObject: []interface{}{
+				map[string]interface{}{
+					"deployment_circuit_breaker": []interface{}{
+						map[string]interface{}{
+							"enable":   true,
+							"rollback": false,
+						},
+					},
+					"maximum_percent":         200,
+					"minimum_healthy_percent": 50,
+				},
+			},
+			Options: []func(*Options){WithNoOmitEmpty("rollback")},
+			Target:  &ecs.DeploymentConfiguration{},
+			Expected: &ecs.DeploymentConfiguration{
+				DeploymentCircuitBreaker: &ecs.DeploymentCircuitBreaker{
+					Enable:   aws.Bool(true),
+					Rollback: aws.Bool(false),
+				},
+				MaximumPercent:        aws.Int64(200),
+				MinimumHealthyPercent: aws.Int64(50),
+			},
+		},
+		{
+			Name: "nested list with field name",
+			TFObject: map[string]interface{}{
+				"filter": []interface{}{
+					map[string]interface{}{
+						"name":   "tag:Name",
+						"values": schema.NewSet(schema.HashString, []interface{}{"example"}),
+					},
+					map[string]interface{}{
+						"name":   "is-default",
+						"values": []interface{}{"false"},
+					},
+				},
+			},
+			Options: []func(*Options){WithFieldName("filter", "Filters")},
+			Target:  &ec2.DescribeVpcsInput{},
+			Expected: &ec2.DescribeVpcsInput{
+				Filters: []*ec2.Filter{
+					{
+						Name:   aws.String("tag:Name"),
+						Values: aws.StringSlice([]string{"example"}),
+					},
+					{
+						Name:   aws.String("is-default"),
+						Values: aws.StringSlice([]string{"false"}),
+					},
+				},
+			},
+		},
+		{
+			Name: "ignored attribute",
+			TFObject: map[string]interface{}{
+				"cidr_block":       "10.0.0.0/16",
+				"instance_tenancy": ec2.TenancyDedicated,
+			},
+			Options: []func(*Options){WithIgnoredAttribute("instance_tenancy")},
+			Target:  &ec2.CreateVpcInput{},
+			Expected: &ec2.CreateVpcInput{
+				CidrBlock: aws.String("10.0.0.0/16"),
+			},
+		},
+		{
+			Name: "time",
+			TFObject: map[string]interface{}{
+				"auto_scaling_group_name": "example",
+				"desired_capacity":        2,
+				"scheduled_action_name":   "example",
+				"start_time":              "2021-11-01T12:00:00Z",
+			},
+			Target: &autoscaling.PutScheduledUpdateGroupActionInput{},
+			Expected: &autoscaling.PutScheduledUpdateGroupActionInput{
+				AutoScalingGroupName: aws.String("example"),
+				DesiredCapacity:      aws.Int64(2),
+				ScheduledActionName:  aws.String("example"),
+				StartTime:            aws.Time(time.Date(2021, time.November, 1, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			Name: "float",
+			TFObject: map[string]interface{}{
+				"predefined_metric_specification": []interface{}{
+					map[string]interface{}{
+						"predefined_metric_type": autoscaling.MetricTypeAsgaverageCpuutilization,
+					},
+				},
+				"target_value": 50.0,
+			},
+			Target: &autoscaling.TargetTrackingConfiguration{},
+			Expected: &autoscaling.TargetTrackingConfiguration{
+				PredefinedMetricSpecification: &autoscaling.PredefinedMetricSpecification{
+					PredefinedMetricType: aws.String(autoscaling.MetricTypeAsgaverageCpuutilization),
+				},
+				TargetValue: aws.Float64(50),
+			},
+		},
+		{
+			Name: "map",
+			TFObject: map[string]interface{}{
+				"variables": map[string]interface{}{
+					"KEY": "value",
+				},
+			},
+			Target: &lambda.Environment{},
+			Expected: &lambda.Environment{
+				Variables: aws.StringMap(map[string]string{"KEY": "value"}),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := Expand(testCase.TFObject, testCase.Target, testCase.Options...)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(testCase.Target, testCase.Expected) {
+				t.Fatalf(
+					"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+					testCase.Target,
+					testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandError(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		TFObject      interface{}
+		Target        interface{}
+		ExpectedError string
+	}{
+		{
+			Name:          "target not a pointer",
+			TFObject:      map[string]interface{}{},
+			Target:        ecs.DeploymentConfiguration{},
+			ExpectedError: "target must be a non-nil pointer to a struct",
+		},
+		{
+			Name: "type mismatch",
+			TFObject: map[string]interface{}{
+				"maximum_percent": "200",
+			},
+			Target:        &ecs.DeploymentConfiguration{},
+			ExpectedError: "expanding maximum_percent: expected an int",
+		},
+		{
+			Name: "nested type mismatch",
+			TFObject: map[string]interface{}{
+				"deployment_circuit_breaker": []interface{}{
+					map[string]interface{}{
+						"enable": "true",
+					},
+				},
+			},
+			Target:        &ecs.DeploymentConfiguration{},
+			ExpectedError: "expanding deployment_circuit_breaker.enable: expected a bool",
+		},
+		{
+			Name: "invalid time",
+			TFObject: map[string]interface{}{
+				"start_time": "tomorrow",
+			},
+			Target:        &autoscaling.PutScheduledUpdateGroupActionInput{},
+			ExpectedError: "expanding start_time",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := Expand(testCase.TFObject, testCase.Target)
+
+			if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		APIObject interface{}
+		Options   []func(*Options)
+		Expected  []interface{}
+	}{
+		{
+			Name:      "nil",
+			APIObject: (*ecs.DeploymentConfiguration)(nil),
+			Expected:  []interface{}{},
+		},
+		{
+			Name: "scalars and enum",
+			APIObject: &ec2.Vpc{
+				CidrBlock:       aws.String("10.0.0.0/16"),
+				InstanceTenancy: aws.String(ec2.TenancyDefault),
+				IsDefault:       aws.Bool(false),
+				State:           aws.String(ec2.VpcStateAvailable),
+				Tags: []*ec2.Tag{
+					{
+						Key:   aws.String("Name"),
+						Value: aws.String("example"),
+					},
+				},
+				VpcId: aws.String("vpc-12345678"),
+			},
+			Options: []func(*Options){WithIgnoredAttribute("tags")},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"cidr_block":       "10.0.0.0/16",
+					"instance_tenancy": ec2.TenancyDefault,
+					"is_default":       false,
+					"state":            ec2.VpcStateAvailable,
+					"vpc_id":           "vpc-12345678",
+				},
+			},
+		},
+		{
+			Name: "nested block",
+			APIObject: ecs.DeploymentConfiguration{
+				DeploymentCircuitBreaker: &ecs.DeploymentCircuitBreaker{
+					Enable:   aws.Bool(true),
+					Rollback: aws.Bool(false),
+				},
+				MaximumPercent: aws.Int64(200),
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"deployment_circuit_breaker": []interface{}{
+						map[string]interface{}{
+							"enable":   true,
+							"rollback": false,
+						},
+					},
+					"maximum_percent": 200,
+				},
+			},
+		},
+		{
+			Name: "nested list with field name",
+			APIObject: &ec2.DescribeVpcsInput{
+				Filters: []*ec2.Filter{
+					{
+						Name:   aws.String("tag:Name"),
+						Values: aws.StringSlice([]string{"example"}),
+					},
+				},
+			},
+			Options: []func(*Options){WithFieldName("filter", "Filters")},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"filter": []interface{}{
+						map[string]interface{}{
+							"name":   "tag:Name",
+							"values": []interface{}{"example"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "time and float",
+			APIObject: &autoscaling.ScheduledUpdateGroupAction{
+				DesiredCapacity: aws.Int64(2),
+				StartTime:       aws.Time(time.Date(2021, time.November, 1, 12, 0, 0, 0, time.UTC)),
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"desired_capacity": 2,
+					"start_time":       "2021-11-01T12:00:00Z",
+				},
+			},
+		},
+		{
+			Name: "map",
+			APIObject: &lambda.EnvironmentResponse{
+				Variables: aws.StringMap(map[string]string{"KEY": "value"}),
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"variables": map[string]interface{}{
+						"KEY": "value",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := Flatten(testCase.APIObject, testCase.Options...)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Fatalf(
+					"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+					got,
+					testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenRoundTrip(t *testing.T) {
+	tfList := []interface{}{
+		map[string]interface{}{
+			"predefined_metric_specification": []interface{}{
+				map[string]interface{}{
+					"predefined_metric_type": autoscaling.MetricTypeAsgaverageCpuutilization,
+					"resource_label":         "example",
+				},
+			},
+			"disable_scale_in": true,
+			"target_value":     50.5,
+		},
+	}
+
+	apiObject := &autoscaling.TargetTrackingConfiguration{}
+
+	if err := Expand(tfList, apiObject); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := Flatten(apiObject); !reflect.DeepEqual(got, tfList) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			got,
+			tfList)
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	testCases := map[string]string{
+		"Arn":                  "arn",
+		"ARN":                  "arn",
+		"DBInstanceIdentifier": "db_instance_identifier",
+		"Ipv6CidrBlock":        "ipv6_cidr_block",
+		"KMSKeyId":             "kms_key_id",
+		"S3BucketName":         "s3_bucket_name",
+		"VpcId":                "vpc_id",
+	}
+
+	for name, expected := range testCases {
+		if got := snakeCase(name); got != expected {
+			t.Errorf("snakeCase(%q): got %q, expected %q", name, got, expected)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/resource"
+)
+
+var (
+	awsService        = flag.String("AWSService", "", "AWS SDK for Go service package, defaults to the service package")
+	clientType        = flag.String("ClientType", "", "AWS SDK for Go service client type, defaults to ServiceName")
+	serviceName       = flag.String("ServiceName", "", "service name used in connections, messages and test names, defaults to the upper-cased service package")
+	resourceName      = flag.String("Resource", "", "CamelCase resource name")
+	createOp          = flag.String("CreateOp", "", "create operation")
+	readOp            = flag.String("ReadOp", "", "read operation")
+	updateOp          = flag.String("UpdateOp", "", "update operation, all arguments are ForceNew if not set")
+	deleteOp          = flag.String("DeleteOp", "", "delete operation")
+	listOp            = flag.String("ListOp", "", "list operation used by the sweeper, must have a Pages variant")
+	idShape           = flag.String("IDShape", resource.IDShapeARN, fmt.Sprintf("resource ID shape, one of %s", strings.Join(resource.IDShapes(), ", ")))
+	idFields          = flag.String("IDFields", "", "comma-separated AWS API fields identifying the resource")
+	apiObjectType     = flag.String("APIObjectType", "", "AWS API type describing the resource, defaults to Resource")
+	readOutputField   = flag.String("ReadOutputField", "", "read operation output field holding the APIObjectType, defaults to Resource")
+	createOutputField = flag.String("CreateOutputField", "", "create operation output field holding the APIObjectType, defaults to ReadOutputField")
+	listOutputField   = flag.String("ListOutputField", "", "list operation output field holding the resource summaries, defaults to Resource + \"s\"")
+	arnField          = flag.String("ARNField", "", "APIObjectType field holding the resource's ARN, defaults to Resource + \"Arn\"")
+	nameField         = flag.String("NameField", "", "APIObjectType field holding the resource's name, defaults to Resource + \"Name\"")
+	statusField       = flag.String("StatusField", "", "APIObjectType field holding the resource's status, defaults to \"Status\"")
+	statusEnum        = flag.String("StatusEnum", "", "prefix of the status constants, defaults to APIObjectType + \"Status\"")
+	notFoundErrCode   = flag.String("NotFoundErrCode", "", "error code constant suffix returned for a missing resource, defaults to \"ResourceNotFoundException\"")
+	tags              = flag.Bool("Tags", false, "whether the resource supports tags")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	cfg := resource.Config{
+		ServicePackage:    filepath.Base(wd),
+		AWSService:        *awsService,
+		ClientType:        *clientType,
+		ServiceName:       *serviceName,
+		ResourceName:      *resourceName,
+		CreateOp:          *createOp,
+		ReadOp:            *readOp,
+		UpdateOp:          *updateOp,
+		DeleteOp:          *deleteOp,
+		ListOp:            *listOp,
+		IDShape:           *idShape,
+		APIObjectType:     *apiObjectType,
+		ReadOutputField:   *readOutputField,
+		CreateOutputField: *createOutputField,
+		ListOutputField:   *listOutputField,
+		ARNField:          *arnField,
+		NameField:         *nameField,
+		StatusField:       *statusField,
+		StatusEnum:        *statusEnum,
+		NotFoundErrCode:   *notFoundErrCode,
+		Tags:              *tags,
+	}
+
+	if *idFields != "" {
+		cfg.IDFields = strings.Split(*idFields, ",")
+	}
+
+	output, err := resource.Generate(cfg)
+
+	if err != nil {
+		log.Fatalf("error generating resource: %s", err)
+	}
+
+	for _, filename := range sortedKeys(output.Files) {
+		if _, err := os.Stat(filename); err == nil {
+			log.Fatalf("error writing %s: file already exists", filename)
+		}
+	}
+
+	for _, filename := range sortedKeys(output.Files) {
+		if err := os.WriteFile(filename, output.Files[filename], 0644); err != nil {
+			log.Fatalf("error writing %s: %s", filename, err)
+		}
+
+		log.Printf("Wrote %s", filename)
+	}
+
+	for _, filename := range sortedKeys(output.Fragments) {
+		src := output.Fragments[filename]
+		existing, err := os.ReadFile(filename)
+
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+		case err != nil:
+			log.Fatalf("error reading %s: %s", filename, err)
+		default:
+			if src, err = resource.Merge(existing, src); err != nil {
+				log.Fatalf("error merging %s: %s", filename, err)
+			}
+		}
+
+		if err := os.WriteFile(filename, src, 0644); err != nil {
+			log.Fatalf("error writing %s: %s", filename, err)
+		}
+
+		log.Printf("Wrote %s", filename)
+	}
+
+	log.Printf("\nAdd the resource to the ResourcesMap in internal/provider/provider.go:\n\n\t%s", output.ProviderRegistration)
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,228 @@
+package resource
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Merge merges the declarations of a generated fragment into the existing source of a file.
+// Imports are combined, the body of any init function is appended to the existing init function
+// and all other declarations are appended to the end of the file.
+// It is an error for the fragment to redeclare an existing top-level identifier.
+func Merge(existing, fragment []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+
+	existingFile, err := parser.ParseFile(fset, "existing.go", existing, parser.ParseComments)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing existing source: %w", err)
+	}
+
+	fragmentFile, err := parser.ParseFile(fset, "fragment.go", fragment, parser.ParseComments)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing fragment: %w", err)
+	}
+
+	if existingFile.Name.Name != fragmentFile.Name.Name {
+		return nil, fmt.Errorf("package %s does not match fragment package %s", existingFile.Name.Name, fragmentFile.Name.Name)
+	}
+
+	declared := topLevelNames(existingFile)
+
+	for name := range topLevelNames(fragmentFile) {
+		if declared[name] {
+			return nil, fmt.Errorf("%s is already declared", name)
+		}
+	}
+
+	offset := func(pos token.Pos) int {
+		return fset.Position(pos).Offset
+	}
+
+	var existingInit *ast.FuncDecl
+	var importStart, importEnd int
+
+	for _, decl := range existingFile.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok == token.IMPORT {
+				if importEnd == 0 {
+					importStart = offset(decl.Pos())
+				}
+				importEnd = offset(decl.End())
+			}
+		case *ast.FuncDecl:
+			if isInit(decl) {
+				existingInit = decl
+			}
+		}
+	}
+
+	var initStatements, decls []string
+
+	for _, decl := range fragmentFile.Decls {
+		if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.IMPORT {
+			continue
+		}
+
+		if decl, ok := decl.(*ast.FuncDecl); ok && isInit(decl) && existingInit != nil {
+			for _, stmt := range decl.Body.List {
+				initStatements = append(initStatements, string(fragment[offset(stmt.Pos()):offset(stmt.End())]))
+			}
+
+			continue
+		}
+
+		start := decl.Pos()
+
+		if doc := declDoc(decl); doc != nil {
+			start = doc.Pos()
+		}
+
+		decls = append(decls, string(fragment[offset(start):offset(decl.End())]))
+	}
+
+	imports := importSpecs(existingFile)
+
+	for spec := range importSpecs(fragmentFile) {
+		imports[spec] = true
+	}
+
+	var buf bytes.Buffer
+
+	if importEnd == 0 {
+		// No existing imports, place them after the package clause.
+		importStart = offset(existingFile.Name.End())
+		importEnd = importStart
+		buf.Write(existing[:importStart])
+		buf.WriteString("\n\n")
+	} else {
+		buf.Write(existing[:importStart])
+	}
+
+	buf.WriteString(importDecl(imports))
+
+	rest := existing[importEnd:]
+
+	if existingInit != nil && len(initStatements) > 0 {
+		rbrace := offset(existingInit.Body.Rbrace) - importEnd
+
+		buf.Write(rest[:rbrace])
+		buf.WriteString("\n")
+		buf.WriteString(strings.Join(initStatements, "\n\n"))
+		buf.WriteString("\n")
+		buf.Write(rest[rbrace:])
+	} else {
+		buf.Write(rest)
+	}
+
+	for _, decl := range decls {
+		buf.WriteString("\n\n")
+		buf.WriteString(decl)
+	}
+
+	buf.WriteString("\n")
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		return nil, fmt.Errorf("error formatting merged source: %w", err)
+	}
+
+	return src, nil
+}
+
+func isInit(decl *ast.FuncDecl) bool {
+	return decl.Recv == nil && decl.Name.Name == "init"
+}
+
+func declDoc(decl ast.Decl) *ast.CommentGroup {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		return decl.Doc
+	case *ast.GenDecl:
+		return decl.Doc
+	}
+
+	return nil
+}
+
+// topLevelNames returns the names declared at the top level of a file, ignoring init functions.
+func topLevelNames(file *ast.File) map[string]bool {
+	names := map[string]bool{}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil && !isInit(decl) {
+				names[decl.Name.Name] = true
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names[spec.Name.Name] = true
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						names[name.Name] = true
+					}
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+// importSpecs returns a file's imports as their source text, e.g. `tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`.
+func importSpecs(file *ast.File) map[string]bool {
+	specs := map[string]bool{}
+
+	for _, spec := range file.Imports {
+		v := spec.Path.Value
+
+		if spec.Name != nil {
+			v = spec.Name.Name + " " + v
+		}
+
+		specs[v] = true
+	}
+
+	return specs
+}
+
+// importDecl returns an import declaration with standard library imports grouped before all others.
+func importDecl(specs map[string]bool) string {
+	var std, other []string
+
+	for spec := range specs {
+		path := spec[strings.LastIndex(spec, " ")+1:]
+
+		if p, err := strconv.Unquote(path); err == nil && !strings.Contains(strings.SplitN(p, "/", 2)[0], ".") {
+			std = append(std, spec)
+		} else {
+			other = append(other, spec)
+		}
+	}
+
+	sort.Strings(std)
+	sort.Strings(other)
+
+	var groups []string
+
+	for _, group := range [][]string{std, other} {
+		if len(group) > 0 {
+			groups = append(groups, "\t"+strings.Join(group, "\n\t"))
+		}
+	}
+
+	return "import (\n" + strings.Join(groups, "\n\n") + "\n)"
+}
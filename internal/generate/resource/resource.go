@@ -0,0 +1,385 @@
+// Package resource generates the scaffolding for a new Terraform resource in a service package.
+package resource
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	IDShapeARN       = "arn"
+	IDShapeName      = "name"
+	IDShapeComposite = "composite"
+)
+
+func IDShapes() []string {
+	return []string{
+		IDShapeARN,
+		IDShapeName,
+		IDShapeComposite,
+	}
+}
+
+// Config describes the resource to generate.
+type Config struct {
+	// ServicePackage is the provider service package, e.g. "networkfirewall".
+	ServicePackage string
+	// AWSService is the AWS SDK for Go service package, e.g. "networkfirewall".
+	AWSService string
+	// ClientType is the AWS SDK for Go service client type, e.g. "NetworkFirewall".
+	ClientType string
+	// ServiceName is the human-readable service name used in connections, messages and test names, e.g. "NetworkFirewall".
+	ServiceName string
+	// ResourceName is the CamelCase resource name, e.g. "Firewall".
+	ResourceName string
+
+	CreateOp string
+	ReadOp   string
+	UpdateOp string // Optional, all arguments are ForceNew without an update operation.
+	DeleteOp string
+	ListOp   string // Must have a Pages variant, see internal/generate/listpages.
+
+	// IDShape is one of IDShapes().
+	IDShape string
+	// IDFields are the AWS API fields identifying the resource.
+	// Defaults to <ResourceName>Arn for the "arn" shape and <ResourceName>Name for the "name" shape.
+	// The "composite" shape requires two or more fields, each becoming a Required, ForceNew argument.
+	IDFields []string
+
+	// APIObjectType is the AWS API type describing the resource. Defaults to ResourceName.
+	APIObjectType string
+	// ReadOutputField is the field of the read operation output holding the APIObjectType. Defaults to ResourceName.
+	ReadOutputField string
+	// CreateOutputField is the field of the create operation output holding the APIObjectType. Defaults to ReadOutputField.
+	CreateOutputField string
+	// ListOutputField is the field of the list operation output holding the resource summaries. Defaults to ResourceName + "s".
+	ListOutputField string
+	// ARNField and NameField are the APIObjectType fields holding the resource's ARN and name.
+	// Default to <ResourceName>Arn and <ResourceName>Name.
+	ARNField  string
+	NameField string
+	// StatusField is the APIObjectType field holding the resource's status. Defaults to "Status".
+	StatusField string
+	// StatusEnum is the prefix of the AWS SDK for Go status constants. Defaults to <APIObjectType>Status.
+	StatusEnum string
+	// NotFoundErrCode is the AWS SDK for Go error code constant suffix returned for a missing resource.
+	// Defaults to "ResourceNotFoundException".
+	NotFoundErrCode string
+
+	// Tags enables the tags and tags_all arguments, using the service's generated tags functions.
+	Tags bool
+}
+
+// Output is the result of generating a resource.
+type Output struct {
+	// Files are whole new files, keyed by file name.
+	Files map[string][]byte
+	// Fragments are files, keyed by file name, whose declarations are to be merged into
+	// any existing file of the same name in the service package. See Merge.
+	Fragments map[string][]byte
+	// ProviderRegistration is the ResourcesMap entry to add to internal/provider/provider.go.
+	ProviderRegistration string
+}
+
+type idPart struct {
+	Field     string // AWS API field, e.g. "ClusterName"
+	Attribute string // Terraform attribute, e.g. "cluster_name"
+	Variable  string // Go variable, e.g. "clusterName"
+
+	HCLAttribute string // Terraform attribute padded for alignment in test configurations
+}
+
+type templateData struct {
+	Config
+
+	TypeName             string // aws_networkfirewall_firewall
+	HumanResourceName    string // Firewall
+	ResourceSnakeName    string // firewall
+	ResourceLowerName    string // firewall, as a Go identifier
+	FinderName           string // FindFirewallByARN
+	IDParts              []idPart
+	IDSeparatorConstName string
+	IDParseCondition     string // len(parts) == 2 && parts[0] != "" && parts[1] != ""
+	IDParseReturn        string // parts[0], parts[1]
+	IDParseEmptyReturn   string // "", ""
+	IDExpectedFormat     string // cluster-name%[2]snodegroup-name
+	NameAttribute        bool
+	UpdatableArguments   bool
+}
+
+type waiterTemplateData struct {
+	Data    templateData
+	Suffix  string // Created
+	Pending string // Creating
+	Target  string // Active, or empty for a deleted waiter
+}
+
+// Generate returns the scaffolding for the configured resource.
+func Generate(cfg Config) (*Output, error) {
+	if err := cfg.setDefaults(); err != nil {
+		return nil, err
+	}
+
+	data := newTemplateData(cfg)
+	output := &Output{
+		Files:     map[string][]byte{},
+		Fragments: map[string][]byte{},
+		ProviderRegistration: fmt.Sprintf("%q: %s.Resource%s(),",
+			data.TypeName, cfg.ServicePackage, cfg.ResourceName),
+	}
+
+	for _, file := range []struct {
+		name     string
+		body     string
+		fragment bool
+	}{
+		{name: data.ResourceSnakeName + ".go", body: resourceTemplateBody},
+		{name: data.ResourceSnakeName + "_test.go", body: resourceTestTemplateBody},
+		{name: "find.go", body: findTemplateBody, fragment: true},
+		{name: "status.go", body: statusTemplateBody, fragment: true},
+		{name: "wait.go", body: waitTemplateBody, fragment: true},
+		{name: "sweep.go", body: sweepTemplateBody, fragment: true},
+		{name: "id.go", body: idTemplateBody, fragment: true},
+	} {
+		if file.name == "id.go" && cfg.IDShape != IDShapeComposite {
+			continue
+		}
+
+		src, err := executeTemplate(file.name, file.body, data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if file.fragment {
+			output.Fragments[file.name] = src
+		} else {
+			output.Files[file.name] = src
+		}
+	}
+
+	return output, nil
+}
+
+func (cfg *Config) setDefaults() error {
+	for _, v := range []struct {
+		name  string
+		value string
+	}{
+		{"service package", cfg.ServicePackage},
+		{"resource name", cfg.ResourceName},
+		{"create op", cfg.CreateOp},
+		{"read op", cfg.ReadOp},
+		{"delete op", cfg.DeleteOp},
+		{"list op", cfg.ListOp},
+	} {
+		if v.value == "" {
+			return fmt.Errorf("%s is required", v.name)
+		}
+	}
+
+	if !unicode.IsUpper(rune(cfg.ResourceName[0])) {
+		return fmt.Errorf("resource name (%s) must be CamelCase", cfg.ResourceName)
+	}
+
+	if cfg.AWSService == "" {
+		cfg.AWSService = cfg.ServicePackage
+	}
+
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = strings.ToUpper(cfg.ServicePackage)
+	}
+
+	if cfg.ClientType == "" {
+		cfg.ClientType = cfg.ServiceName
+	}
+
+	if cfg.APIObjectType == "" {
+		cfg.APIObjectType = cfg.ResourceName
+	}
+
+	if cfg.ReadOutputField == "" {
+		cfg.ReadOutputField = cfg.ResourceName
+	}
+
+	if cfg.CreateOutputField == "" {
+		cfg.CreateOutputField = cfg.ReadOutputField
+	}
+
+	if cfg.ListOutputField == "" {
+		cfg.ListOutputField = cfg.ResourceName + "s"
+	}
+
+	if cfg.ARNField == "" {
+		cfg.ARNField = cfg.ResourceName + "Arn"
+	}
+
+	if cfg.NameField == "" {
+		cfg.NameField = cfg.ResourceName + "Name"
+	}
+
+	if cfg.StatusField == "" {
+		cfg.StatusField = "Status"
+	}
+
+	if cfg.StatusEnum == "" {
+		cfg.StatusEnum = cfg.APIObjectType + "Status"
+	}
+
+	if cfg.NotFoundErrCode == "" {
+		cfg.NotFoundErrCode = "ResourceNotFoundException"
+	}
+
+	switch cfg.IDShape {
+	case IDShapeARN:
+		if len(cfg.IDFields) == 0 {
+			cfg.IDFields = []string{cfg.ARNField}
+		}
+	case IDShapeName:
+		if len(cfg.IDFields) == 0 {
+			cfg.IDFields = []string{cfg.NameField}
+		}
+	case IDShapeComposite:
+		if len(cfg.IDFields) < 2 {
+			return fmt.Errorf("ID shape (%s) requires at least 2 ID fields, got %d", cfg.IDShape, len(cfg.IDFields))
+		}
+	default:
+		return fmt.Errorf("ID shape (%s) must be one of %s", cfg.IDShape, strings.Join(IDShapes(), ", "))
+	}
+
+	if cfg.IDShape != IDShapeComposite && len(cfg.IDFields) != 1 {
+		return fmt.Errorf("ID shape (%s) requires exactly 1 ID field, got %d", cfg.IDShape, len(cfg.IDFields))
+	}
+
+	return nil
+}
+
+func newTemplateData(cfg Config) templateData {
+	words := splitCamelCase(cfg.ResourceName)
+	snakeName := strings.ToLower(strings.Join(words, "_"))
+	lowerName := strings.ToLower(cfg.ResourceName[:1]) + cfg.ResourceName[1:]
+
+	data := templateData{
+		Config:             cfg,
+		TypeName:           fmt.Sprintf("aws_%s_%s", cfg.ServicePackage, snakeName),
+		HumanResourceName:  strings.Join(words, " "),
+		ResourceSnakeName:  snakeName,
+		ResourceLowerName:  lowerName,
+		NameAttribute:      cfg.IDShape != IDShapeComposite,
+		UpdatableArguments: cfg.UpdateOp != "",
+	}
+
+	switch cfg.IDShape {
+	case IDShapeARN:
+		data.FinderName = fmt.Sprintf("Find%sByARN", cfg.ResourceName)
+		data.IDParts = []idPart{{Field: cfg.IDFields[0], Attribute: "arn", Variable: "arn"}}
+	case IDShapeName:
+		data.FinderName = fmt.Sprintf("Find%sByName", cfg.ResourceName)
+		data.IDParts = []idPart{{Field: cfg.IDFields[0], Attribute: "name", Variable: "name"}}
+	case IDShapeComposite:
+		data.FinderName = fmt.Sprintf("Find%sBy%s", cfg.ResourceName, strings.Join(cfg.IDFields, "And"))
+		data.IDSeparatorConstName = lowerName + "ResourceIDSeparator"
+
+		for _, field := range cfg.IDFields {
+			fieldWords := splitCamelCase(field)
+			variable := strings.ToLower(field[:1]) + field[1:]
+
+			// Avoid shadowing the id variable or the name of a Go type.
+			if variable == "id" {
+				variable = lowerName + "ID"
+			}
+
+			data.IDParts = append(data.IDParts, idPart{
+				Field:     field,
+				Attribute: strings.ToLower(strings.Join(fieldWords, "_")),
+				Variable:  variable,
+			})
+		}
+
+		var conditions, parts, empty, format []string
+
+		conditions = append(conditions, fmt.Sprintf("len(parts) == %d", len(data.IDParts)))
+
+		for i, part := range data.IDParts {
+			conditions = append(conditions, fmt.Sprintf(`parts[%d] != ""`, i))
+			parts = append(parts, fmt.Sprintf("parts[%d]", i))
+			empty = append(empty, `""`)
+			format = append(format, strings.ReplaceAll(part.Attribute, "_", "-"))
+		}
+
+		data.IDParseCondition = strings.Join(conditions, " && ")
+		data.IDParseReturn = strings.Join(parts, ", ")
+		data.IDParseEmptyReturn = strings.Join(empty, ", ")
+		data.IDExpectedFormat = strings.Join(format, "%[2]s")
+	}
+
+	width := 0
+
+	for _, part := range data.IDParts {
+		if len(part.Attribute) > width {
+			width = len(part.Attribute)
+		}
+	}
+
+	for i := range data.IDParts {
+		data.IDParts[i].HCLAttribute = fmt.Sprintf("%-*s", width, data.IDParts[i].Attribute)
+	}
+
+	return data
+}
+
+func executeTemplate(name, body string, data templateData) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(template.FuncMap{
+		"waiter": func(data templateData, suffix, pending, target string) waiterTemplateData {
+			return waiterTemplateData{
+				Data:    data,
+				Suffix:  suffix,
+				Pending: pending,
+				Target:  target,
+			}
+		},
+	}).Parse(body)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s template: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("error executing %s template: %w", name, err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated %s: %w\n%s", name, err, buf.String())
+	}
+
+	return src, nil
+}
+
+// splitCamelCase splits a CamelCase name into its words, keeping acronyms together,
+// e.g. "DBClusterEndpoint" becomes "DB", "Cluster", "Endpoint".
+func splitCamelCase(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := 0
+
+	for i := 1; i < len(runes); i++ {
+		lowerToUpper := unicode.IsLower(runes[i-1]) && unicode.IsUpper(runes[i])
+		acronymEnd := unicode.IsUpper(runes[i-1]) && unicode.IsUpper(runes[i]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		digitToUpper := unicode.IsDigit(runes[i-1]) && unicode.IsUpper(runes[i])
+
+		if lowerToUpper || acronymEnd || digitToUpper {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	return append(words, string(runes[start:]))
+}
@@ -0,0 +1,295 @@
+package resource
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// testGolden compares got with the golden file at path, rewriting the golden file with -update.
+func testGolden(t *testing.T, path string, got []byte) {
+	t.Helper()
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("error creating golden file directory: %s", err)
+		}
+
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("error writing golden file: %s", err)
+		}
+
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("error reading golden file (run with -update to create it): %s", err)
+	}
+
+	if string(got) != string(expected) {
+		t.Errorf("%s does not match golden file (run with -update to update it), got:\n%s", path, got)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		Config               Config
+		ExpectedRegistration string
+	}{
+		{
+			TestName: "arn",
+			Config: Config{
+				ServicePackage: "networkfirewall",
+				ServiceName:    "NetworkFirewall",
+				ResourceName:   "Firewall",
+				CreateOp:       "CreateFirewall",
+				ReadOp:         "DescribeFirewall",
+				UpdateOp:       "UpdateFirewallDescription",
+				DeleteOp:       "DeleteFirewall",
+				ListOp:         "ListFirewalls",
+				IDShape:        IDShapeARN,
+				StatusEnum:     "FirewallStatusValue",
+				Tags:           true,
+			},
+			ExpectedRegistration: `"aws_networkfirewall_firewall": networkfirewall.ResourceFirewall(),`,
+		},
+		{
+			TestName: "name",
+			Config: Config{
+				ServicePackage:  "memorydb",
+				AWSService:      "memorydb",
+				ClientType:      "MemoryDB",
+				ServiceName:     "MemoryDB",
+				ResourceName:    "SubnetGroup",
+				CreateOp:        "CreateSubnetGroup",
+				ReadOp:          "DescribeSubnetGroups",
+				DeleteOp:        "DeleteSubnetGroup",
+				ListOp:          "DescribeSubnetGroups",
+				IDShape:         IDShapeName,
+				IDFields:        []string{"SubnetGroupName"},
+				ARNField:        "ARN",
+				NameField:       "Name",
+				NotFoundErrCode: "SubnetGroupNotFoundFault",
+			},
+			ExpectedRegistration: `"aws_memorydb_subnet_group": memorydb.ResourceSubnetGroup(),`,
+		},
+		{
+			TestName: "composite",
+			Config: Config{
+				ServicePackage:  "eks",
+				ServiceName:     "EKS",
+				ResourceName:    "Addon",
+				CreateOp:        "CreateAddon",
+				ReadOp:          "DescribeAddon",
+				UpdateOp:        "UpdateAddon",
+				DeleteOp:        "DeleteAddon",
+				ListOp:          "ListAddons",
+				IDShape:         IDShapeComposite,
+				IDFields:        []string{"ClusterName", "AddonName"},
+				ListOutputField: "AddonSummaries",
+				Tags:            true,
+			},
+			ExpectedRegistration: `"aws_eks_addon": eks.ResourceAddon(),`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			output, err := Generate(testCase.Config)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			dir := filepath.Join("testdata", testCase.TestName)
+			var got []string
+
+			for _, files := range []map[string][]byte{output.Files, output.Fragments} {
+				for filename, src := range files {
+					got = append(got, filename+".golden")
+					testGolden(t, filepath.Join(dir, filename+".golden"), src)
+				}
+			}
+
+			entries, err := os.ReadDir(dir)
+
+			if err != nil {
+				t.Fatalf("error reading golden files: %s", err)
+			}
+
+			var expected []string
+
+			for _, entry := range entries {
+				expected = append(expected, entry.Name())
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, expected) {
+				t.Errorf("got files %v, expected %v", got, expected)
+			}
+
+			if output.ProviderRegistration != testCase.ExpectedRegistration {
+				t.Errorf("got provider registration %s, expected %s", output.ProviderRegistration, testCase.ExpectedRegistration)
+			}
+		})
+	}
+}
+
+func TestGenerateError(t *testing.T) {
+	valid := Config{
+		ServicePackage: "eks",
+		ResourceName:   "Addon",
+		CreateOp:       "CreateAddon",
+		ReadOp:         "DescribeAddon",
+		DeleteOp:       "DeleteAddon",
+		ListOp:         "ListAddons",
+		IDShape:        IDShapeARN,
+	}
+
+	testCases := []struct {
+		TestName      string
+		Modify        func(*Config)
+		ExpectedError string
+	}{
+		{
+			TestName:      "missing read op",
+			Modify:        func(cfg *Config) { cfg.ReadOp = "" },
+			ExpectedError: "read op is required",
+		},
+		{
+			TestName:      "lower case resource name",
+			Modify:        func(cfg *Config) { cfg.ResourceName = "addon" },
+			ExpectedError: "must be CamelCase",
+		},
+		{
+			TestName:      "unknown ID shape",
+			Modify:        func(cfg *Config) { cfg.IDShape = "uuid" },
+			ExpectedError: "must be one of arn, name, composite",
+		},
+		{
+			TestName: "composite with one field",
+			Modify: func(cfg *Config) {
+				cfg.IDShape = IDShapeComposite
+				cfg.IDFields = []string{"AddonName"}
+			},
+			ExpectedError: "requires at least 2 ID fields",
+		},
+		{
+			TestName:      "arn with two fields",
+			Modify:        func(cfg *Config) { cfg.IDFields = []string{"ClusterName", "AddonArn"} },
+			ExpectedError: "requires exactly 1 ID field",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			cfg := valid
+			testCase.Modify(&cfg)
+
+			_, err := Generate(cfg)
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("got error %q, expected to contain %q", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	testCases := []string{
+		"imports",
+		"init",
+		"no_imports",
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase, func(t *testing.T) {
+			dir := filepath.Join("testdata", "merge", testCase)
+
+			existing, err := os.ReadFile(filepath.Join(dir, "existing.golden"))
+
+			if err != nil {
+				t.Fatalf("error reading existing source: %s", err)
+			}
+
+			fragment, err := os.ReadFile(filepath.Join(dir, "fragment.golden"))
+
+			if err != nil {
+				t.Fatalf("error reading fragment: %s", err)
+			}
+
+			got, err := Merge(existing, fragment)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			testGolden(t, filepath.Join(dir, "merged.golden"), got)
+		})
+	}
+}
+
+func TestMergeRedeclared(t *testing.T) {
+	existing := []byte("package eks\n\nfunc FindAddonByClusterNameAndAddonName() {}\n")
+	fragment := []byte("package eks\n\nfunc FindAddonByClusterNameAndAddonName() {}\n")
+
+	_, err := Merge(existing, fragment)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if expected := "FindAddonByClusterNameAndAddonName is already declared"; !strings.Contains(err.Error(), expected) {
+		t.Errorf("got error %q, expected to contain %q", err, expected)
+	}
+}
+
+func TestSplitCamelCase(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected []string
+	}{
+		{
+			Input:    "Firewall",
+			Expected: []string{"Firewall"},
+		},
+		{
+			Input:    "SubnetGroup",
+			Expected: []string{"Subnet", "Group"},
+		},
+		{
+			Input:    "DBClusterEndpoint",
+			Expected: []string{"DB", "Cluster", "Endpoint"},
+		},
+		{
+			Input:    "S3AccessPoint",
+			Expected: []string{"S3", "Access", "Point"},
+		},
+		{
+			Input:    "VPCEndpoint",
+			Expected: []string{"VPC", "Endpoint"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Input, func(t *testing.T) {
+			if got := splitCamelCase(testCase.Input); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,710 @@
+package resource
+
+// Shared template definitions, included in each template.
+const definitionsTemplateBody = `
+{{- define "idParams" -}}
+{{range $i, $p := .IDParts}}{{if $i}}, {{end}}{{$p.Variable}}{{end}} string
+{{- end -}}
+
+{{- define "idVars" -}}
+{{range $i, $p := .IDParts}}{{if $i}}, {{end}}{{$p.Variable}}{{end}}
+{{- end -}}
+
+{{- define "idArgs" -}}
+{{if eq .IDShape "composite"}}{{template "idVars" .}}{{else}}d.Id(){{end}}
+{{- end -}}
+
+{{- define "parseID" -}}
+{{if eq .IDShape "composite"}}
+	{{template "idVars" .}}, err := {{.ResourceName}}ParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+{{end}}
+{{- end -}}
+
+{{- define "inputIDFields" -}}
+{{if eq .IDShape "composite"}}{{range .IDParts}}
+		{{.Field}}: aws.String({{.Variable}}),{{end}}{{else}}
+		{{(index .IDParts 0).Field}}: aws.String(d.Id()),{{end}}
+{{- end -}}
+
+{{- define "declare" -}}
+{{if eq .IDShape "composite"}}={{else}}:={{end}}
+{{- end -}}
+`
+
+var resourceTemplateBody = definitionsTemplateBody + `
+package {{.ServicePackage}}
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/{{.AWSService}}"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+{{- if .Tags}}
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+{{- end}}
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+{{- if .Tags}}
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+{{- end}}
+)
+
+func Resource{{.ResourceName}}() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resource{{.ResourceName}}Create,
+		ReadContext:   resource{{.ResourceName}}Read,
+{{- if or .UpdatableArguments .Tags}}
+		UpdateContext: resource{{.ResourceName}}Update,
+{{- end}}
+		DeleteContext: resource{{.ResourceName}}Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+{{- if .UpdatableArguments}}
+			Update: schema.DefaultTimeout(30 * time.Minute),
+{{- end}}
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+{{if .Tags}}
+		CustomizeDiff: verify.SetTagsDiff,
+{{end}}
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+{{- range .IDParts}}{{if eq .Attribute "arn"}}{{else}}
+			"{{.Attribute}}": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+{{- end}}{{end}}
+{{- if eq .IDShape "arn"}}
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+{{- end}}
+{{- if .Tags}}
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+{{- end}}
+			// TODO: add the resource's remaining arguments and attributes.
+		},
+	}
+}
+
+func resource{{.ResourceName}}Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).{{.ServiceName}}Conn()
+{{- if .Tags}}
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+{{- end}}
+{{if .NameAttribute}}
+	name := d.Get("name").(string)
+	input := &{{.AWSService}}.{{.CreateOp}}Input{
+		{{if eq .IDShape "name"}}{{(index .IDParts 0).Field}}{{else}}{{.NameField}}{{end}}: aws.String(name),
+	}
+{{- else}}
+{{- range .IDParts}}
+	{{.Variable}} := d.Get("{{.Attribute}}").(string)
+{{- end}}
+	id := {{.ResourceName}}CreateResourceID({{template "idVars" .}})
+	input := &{{.AWSService}}.{{.CreateOp}}Input{
+{{- range .IDParts}}
+		{{.Field}}: aws.String({{.Variable}}),
+{{- end}}
+	}
+{{- end}}
+
+	// TODO: set the resource's remaining arguments.
+{{if .Tags}}
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+{{end}}
+	log.Printf("[DEBUG] Creating {{.ServiceName}} {{.HumanResourceName}}: %s", input)
+	{{if eq .IDShape "arn"}}output{{else}}_{{end}}, err := conn.{{.CreateOp}}WithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating {{.ServiceName}} {{.HumanResourceName}} (%s): %w", {{if .NameAttribute}}name{{else}}id{{end}}, err))
+	}
+{{if eq .IDShape "arn"}}
+	d.SetId(aws.StringValue(output.{{.CreateOutputField}}.{{(index .IDParts 0).Field}}))
+{{- else if eq .IDShape "name"}}
+	d.SetId(name)
+{{- else}}
+	d.SetId(id)
+{{- end}}
+
+	if _, err := wait{{.ResourceName}}Created(ctx, conn, {{template "idArgs" .}}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for {{.ServiceName}} {{.HumanResourceName}} (%s) create: %w", d.Id(), err))
+	}
+
+	return resource{{.ResourceName}}Read(ctx, d, meta)
+}
+
+func resource{{.ResourceName}}Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).{{.ServiceName}}Conn()
+{{- if .Tags}}
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+{{- end}}
+{{template "parseID" .}}
+	output, err := {{.FinderName}}(ctx, conn, {{template "idArgs" .}})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] {{.ServiceName}} {{.HumanResourceName}} (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading {{.ServiceName}} {{.HumanResourceName}} (%s): %w", d.Id(), err))
+	}
+
+	d.Set("arn", output.{{.ARNField}})
+{{- if .NameAttribute}}
+	d.Set("name", output.{{.NameField}})
+{{- else}}
+{{- range .IDParts}}
+	d.Set("{{.Attribute}}", output.{{.Field}})
+{{- end}}
+{{- end}}
+	// TODO: set the resource's remaining arguments and attributes.
+{{if .Tags}}
+	tags, err := ListTags(conn, aws.StringValue(output.{{.ARNField}}))
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tags for {{.ServiceName}} {{.HumanResourceName}} (%s): %w", d.Id(), err))
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+{{end}}
+	return nil
+}
+{{if or .UpdatableArguments .Tags}}
+func resource{{.ResourceName}}Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).{{.ServiceName}}Conn()
+{{if .UpdatableArguments}}
+{{- if .Tags}}
+	if d.HasChangesExcept("tags", "tags_all") {
+{{- end}}
+{{- template "parseID" .}}
+	input := &{{.AWSService}}.{{.UpdateOp}}Input{
+{{- template "inputIDFields" .}}
+	}
+
+	// TODO: set the resource's changed arguments.
+
+	log.Printf("[DEBUG] Updating {{.ServiceName}} {{.HumanResourceName}}: %s", input)
+	if _, err := conn.{{.UpdateOp}}WithContext(ctx, input); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating {{.ServiceName}} {{.HumanResourceName}} (%s): %w", d.Id(), err))
+	}
+
+	if _, err := wait{{.ResourceName}}Updated(ctx, conn, {{template "idArgs" .}}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for {{.ServiceName}} {{.HumanResourceName}} (%s) update: %w", d.Id(), err))
+	}
+{{- if .Tags}}
+	}
+{{- end}}
+{{end}}
+{{- if .Tags}}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating {{.ServiceName}} {{.HumanResourceName}} (%s) tags: %w", d.Id(), err))
+		}
+	}
+{{end}}
+	return resource{{.ResourceName}}Read(ctx, d, meta)
+}
+{{end}}
+func resource{{.ResourceName}}Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).{{.ServiceName}}Conn()
+{{template "parseID" .}}
+	log.Printf("[DEBUG] Deleting {{.ServiceName}} {{.HumanResourceName}}: %s", d.Id())
+	_, err {{template "declare" .}} conn.{{.DeleteOp}}WithContext(ctx, &{{.AWSService}}.{{.DeleteOp}}Input{
+{{- template "inputIDFields" .}}
+	})
+
+	if tfawserr.ErrCodeEquals(err, {{.AWSService}}.ErrCode{{.NotFoundErrCode}}) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting {{.ServiceName}} {{.HumanResourceName}} (%s): %w", d.Id(), err))
+	}
+
+	if _, err := wait{{.ResourceName}}Deleted(ctx, conn, {{template "idArgs" .}}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for {{.ServiceName}} {{.HumanResourceName}} (%s) delete: %w", d.Id(), err))
+	}
+
+	return nil
+}
+`
+
+var resourceTestTemplateBody = definitionsTemplateBody + `
+{{- define "testParseID" -}}
+{{if eq .IDShape "composite"}}
+		{{template "idVars" .}}, err := tf{{.ServicePackage}}.{{.ResourceName}}ParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+{{end}}
+{{- end -}}
+
+{{- define "testIDArgs" -}}
+{{if eq .IDShape "composite"}}{{template "idVars" .}}{{else}}rs.Primary.ID{{end}}
+{{- end -}}
+
+{{- define "testConfigArguments" -}}
+{{- if .NameAttribute}}
+  name = %[1]q
+{{- else}}
+{{- range .IDParts}}
+  {{.HCLAttribute}} = %[1]q
+{{- end}}
+{{- end}}
+{{- end -}}
+
+package {{.ServicePackage}}_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/{{.AWSService}}"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tf{{.ServicePackage}} "github.com/hashicorp/terraform-provider-aws/internal/service/{{.ServicePackage}}"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAcc{{.ServiceName}}{{.ResourceName}}_basic(t *testing.T) {
+	var v {{.AWSService}}.{{.APIObjectType}}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "{{.TypeName}}.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService({{.AWSService}}.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, {{.AWSService}}.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheck{{.ResourceName}}Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{.ResourceName}}Config(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{.ResourceName}}Exists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+{{- if .NameAttribute}}
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+{{- else}}
+{{- range .IDParts}}
+					resource.TestCheckResourceAttr(resourceName, "{{.Attribute}}", rName),
+{{- end}}
+{{- end}}
+{{- if .Tags}}
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+{{- end}}
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc{{.ServiceName}}{{.ResourceName}}_disappears(t *testing.T) {
+	var v {{.AWSService}}.{{.APIObjectType}}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "{{.TypeName}}.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService({{.AWSService}}.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, {{.AWSService}}.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheck{{.ResourceName}}Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{.ResourceName}}Config(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{.ResourceName}}Exists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tf{{.ServicePackage}}.Resource{{.ResourceName}}(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+{{if .Tags}}
+func TestAcc{{.ServiceName}}{{.ResourceName}}_tags(t *testing.T) {
+	var v {{.AWSService}}.{{.APIObjectType}}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "{{.TypeName}}.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService({{.AWSService}}.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, {{.AWSService}}.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheck{{.ResourceName}}Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{.ResourceName}}Tags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{.ResourceName}}Exists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAcc{{.ResourceName}}Tags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{.ResourceName}}Exists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAcc{{.ResourceName}}Tags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{.ResourceName}}Exists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+{{end}}
+func testAccCheck{{.ResourceName}}Exists(n string, v *{{.AWSService}}.{{.APIObjectType}}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No {{.ServiceName}} {{.HumanResourceName}} ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).{{.ServiceName}}Conn()
+{{template "testParseID" .}}
+		output, err := tf{{.ServicePackage}}.{{.FinderName}}(context.Background(), conn, {{template "testIDArgs" .}})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheck{{.ResourceName}}Destroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).{{.ServiceName}}Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "{{.TypeName}}" {
+			continue
+		}
+{{template "testParseID" .}}
+		_, err {{template "declare" .}} tf{{.ServicePackage}}.{{.FinderName}}(context.Background(), conn, {{template "testIDArgs" .}})
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("{{.ServiceName}} {{.HumanResourceName}} %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAcc{{.ResourceName}}Config(rName string) string {
+	return fmt.Sprintf(` + "`" + `
+resource "{{.TypeName}}" "test" {
+{{- template "testConfigArguments" .}}
+}
+` + "`" + `, rName)
+}
+{{if .Tags}}
+func testAcc{{.ResourceName}}Tags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(` + "`" + `
+resource "{{.TypeName}}" "test" {
+{{- template "testConfigArguments" .}}
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+` + "`" + `, rName, tagKey1, tagValue1)
+}
+
+func testAcc{{.ResourceName}}Tags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(` + "`" + `
+resource "{{.TypeName}}" "test" {
+{{- template "testConfigArguments" .}}
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+` + "`" + `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+{{end}}`
+
+var findTemplateBody = definitionsTemplateBody + `
+package {{.ServicePackage}}
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/{{.AWSService}}"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func {{.FinderName}}(ctx context.Context, conn *{{.AWSService}}.{{.ClientType}}, {{template "idParams" .}}) (*{{.AWSService}}.{{.APIObjectType}}, error) {
+	input := &{{.AWSService}}.{{.ReadOp}}Input{
+{{- range .IDParts}}
+		{{.Field}}: aws.String({{.Variable}}),
+{{- end}}
+	}
+
+	output, err := conn.{{.ReadOp}}WithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, {{.AWSService}}.ErrCode{{.NotFoundErrCode}}) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.{{.ReadOutputField}} == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.{{.ReadOutputField}}, nil
+}
+`
+
+var statusTemplateBody = definitionsTemplateBody + `
+package {{.ServicePackage}}
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/{{.AWSService}}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func status{{.ResourceName}}(ctx context.Context, conn *{{.AWSService}}.{{.ClientType}}, {{template "idParams" .}}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := {{.FinderName}}(ctx, conn, {{template "idVars" .}})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.{{.StatusField}}), nil
+	}
+}
+`
+
+var waitTemplateBody = definitionsTemplateBody + `
+{{- define "waiter" -}}
+func wait{{.Data.ResourceName}}{{.Suffix}}(ctx context.Context, conn *{{.Data.AWSService}}.{{.Data.ClientType}}, {{template "idParams" .Data}}, timeout time.Duration) (*{{.Data.AWSService}}.{{.Data.APIObjectType}}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ {{- .Data.AWSService}}.{{.Data.StatusEnum}}{{.Pending -}} },
+		Target:  []string{ {{- if .Target}}{{.Data.AWSService}}.{{.Data.StatusEnum}}{{.Target}}{{end -}} },
+		Refresh: status{{.Data.ResourceName}}(ctx, conn, {{template "idVars" .Data}}),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*{{.Data.AWSService}}.{{.Data.APIObjectType}}); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+{{- end -}}
+
+package {{.ServicePackage}}
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/{{.AWSService}}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+{{template "waiter" (waiter . "Created" "Creating" "Active")}}
+{{if .UpdatableArguments}}
+{{template "waiter" (waiter . "Updated" "Updating" "Active")}}
+{{end}}
+{{template "waiter" (waiter . "Deleted" "Deleting" "")}}
+`
+
+var sweepTemplateBody = definitionsTemplateBody + `
+//go:build sweep
+// +build sweep
+
+package {{.ServicePackage}}
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/{{.AWSService}}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("{{.TypeName}}", &resource.Sweeper{
+		Name: "{{.TypeName}}",
+		F:    sweep{{.ResourceName}}s,
+	})
+}
+
+func sweep{{.ResourceName}}s(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).{{.ServiceName}}Conn()
+	input := &{{.AWSService}}.{{.ListOp}}Input{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.{{.ListOp}}Pages(input, func(page *{{.AWSService}}.{{.ListOp}}Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.{{.ListOutputField}} {
+			r := Resource{{.ResourceName}}()
+			d := r.Data(nil)
+{{- if eq .IDShape "composite"}}
+			d.SetId({{.ResourceName}}CreateResourceID({{range $i, $p := .IDParts}}{{if $i}}, {{end}}aws.StringValue(v.{{$p.Field}}){{end}}))
+{{- else}}
+			d.SetId(aws.StringValue(v.{{(index .IDParts 0).Field}}))
+{{- end}}
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping {{.ServiceName}} {{.HumanResourceName}} sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing {{.ServiceName}} {{.HumanResourceName}}s (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping {{.ServiceName}} {{.HumanResourceName}}s (%s): %w", region, err)
+	}
+
+	return nil
+}
+`
+
+var idTemplateBody = definitionsTemplateBody + `
+package {{.ServicePackage}}
+
+import (
+	"fmt"
+	"strings"
+)
+
+const {{.IDSeparatorConstName}} = ":"
+
+func {{.ResourceName}}CreateResourceID({{template "idParams" .}}) string {
+	parts := []string{ {{- template "idVars" . -}} }
+	id := strings.Join(parts, {{.IDSeparatorConstName}})
+
+	return id
+}
+
+func {{.ResourceName}}ParseResourceID(id string) ({{range .IDParts}}string, {{end}}error) {
+	parts := strings.Split(id, {{.IDSeparatorConstName}})
+
+	if {{.IDParseCondition}} {
+		return {{.IDParseReturn}}, nil
+	}
+
+	return {{.IDParseEmptyReturn}}, fmt.Errorf("unexpected format for ID (%[1]s), expected {{.IDExpectedFormat}}", id, {{.IDSeparatorConstName}})
+}
+`
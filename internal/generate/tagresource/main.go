@@ -15,7 +15,9 @@ import (
 )
 
 var (
-	idAttribName = flag.String("IDAttribName", "resource_arn", "idAttribName")
+	idAttribName   = flag.String("IDAttribName", "resource_arn", "idAttribName")
+	skipTestFile   = flag.Bool("SkipTestFile", false, "whether to skip generating the acceptance test helpers")
+	tfResourceType = flag.String("TFResourceType", "", "Terraform resource type, defaults to aws_<service>_tag")
 )
 
 func usage() {
@@ -26,11 +28,12 @@ func usage() {
 }
 
 type TemplateData struct {
-	AWSService      string
-	AWSServiceUpper string
-	ServicePackage  string
+	AWSClientConnName string
+	AWSService        string
+	ServicePackage    string
 
-	IDAttribName string
+	IDAttribName   string
+	TFResourceType string
 }
 
 func main() {
@@ -45,17 +48,24 @@ func main() {
 		log.Fatalf("encountered: %s", err)
 	}
 
-	awsServiceUpper, err := awsServiceNameUpper(servicePackage)
+	awsClientConnName, err := awsClientConnName(servicePackage)
 
 	if err != nil {
 		log.Fatalf("encountered: %s", err)
 	}
 
+	resourceType := *tfResourceType
+
+	if resourceType == "" {
+		resourceType = fmt.Sprintf("aws_%s_tag", servicePackage)
+	}
+
 	templateData := TemplateData{
-		AWSService:      awsService,
-		AWSServiceUpper: awsServiceUpper,
-		ServicePackage:  servicePackage,
-		IDAttribName:    *idAttribName,
+		AWSClientConnName: awsClientConnName,
+		AWSService:        awsService,
+		ServicePackage:    servicePackage,
+		IDAttribName:      *idAttribName,
+		TFResourceType:    resourceType,
 	}
 
 	resourceFilename := "tag_gen.go"
@@ -65,6 +75,10 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if *skipTestFile {
+		return
+	}
+
 	if err := generateTemplateFile(resourceTestFilename, resourceTestTemplateBody, templateData); err != nil {
 		log.Fatal(err)
 	}
@@ -154,7 +168,7 @@ func ResourceTag() *schema.Resource {
 }
 
 func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSClientConnName }}Conn()
 
 	identifier := d.Get("{{ .IDAttribName }}").(string)
 	key := d.Get("key").(string)
@@ -165,7 +179,7 @@ func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
 	{{- else }}
 	if err := UpdateTags(conn, identifier, nil, map[string]string{key: value}); err != nil {
 	{{- end }}
-		return fmt.Errorf("error creating %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
+		return fmt.Errorf("error creating %s resource (%s) tag (%s): %w", {{ .AWSService }}.ServiceID, identifier, key, err)
 	}
 
 	d.SetId(tftags.SetResourceID(identifier, key))
@@ -174,7 +188,7 @@ func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSClientConnName }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -184,13 +198,13 @@ func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
 	value, err := GetTag(conn, identifier, key)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
-		log.Printf("[WARN] %s resource (%s) tag (%s) not found, removing from state", {{ .ServicePackage }}.ServiceID, identifier, key)
+		log.Printf("[WARN] %s resource (%s) tag (%s) not found, removing from state", {{ .AWSService }}.ServiceID, identifier, key)
 		d.SetId("")
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
+		return fmt.Errorf("error reading %s resource (%s) tag (%s): %w", {{ .AWSService }}.ServiceID, identifier, key, err)
 	}
 
 	d.Set("{{ .IDAttribName }}", identifier)
@@ -201,7 +215,7 @@ func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSClientConnName }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -209,14 +223,14 @@ func resourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := UpdateTags(conn, identifier, nil, map[string]string{key: d.Get("value").(string)}); err != nil {
-		return fmt.Errorf("error updating %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
+		return fmt.Errorf("error updating %s resource (%s) tag (%s): %w", {{ .AWSService }}.ServiceID, identifier, key, err)
 	}
 
 	return resourceTagRead(d, meta)
 }
 
 func resourceTagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSClientConnName }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -224,7 +238,7 @@ func resourceTagDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := UpdateTags(conn, identifier, map[string]string{key: d.Get("value").(string)}, nil); err != nil {
-		return fmt.Errorf("error deleting %s resource (%s) tag (%s): %w", {{ .ServicePackage }}.ServiceID, identifier, key, err)
+		return fmt.Errorf("error deleting %s resource (%s) tag (%s): %w", {{ .AWSService }}.ServiceID, identifier, key, err)
 	}
 
 	return nil
@@ -249,10 +263,10 @@ import (
 )
 
 func testAccCheckTagDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSClientConnName }}Conn()
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_{{ .ServicePackage }}_tag" {
+		if rs.Type != "{{ .TFResourceType }}" {
 			continue
 		}
 
@@ -272,7 +286,7 @@ func testAccCheckTagDestroy(s *terraform.State) error {
 			return err
 		}
 
-		return fmt.Errorf("%s resource (%s) tag (%s) still exists", {{ .ServicePackage }}.ServiceID, identifier, key)
+		return fmt.Errorf("%s resource (%s) tag (%s) still exists", {{ .AWSService }}.ServiceID, identifier, key)
 	}
 
 	return nil
@@ -295,7 +309,7 @@ func testAccCheckTagExists(resourceName string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSClientConnName }}Conn()
 
 		_, err = tf{{ .ServicePackage }}.GetTag(conn, identifier, key)
 
@@ -309,16 +323,6 @@ func testAccCheckTagExists(resourceName string) resource.TestCheckFunc {
 `
 )
 
-var awsServiceNames map[string]string
-
-func init() {
-	awsServiceNames = make(map[string]string)
-
-	awsServiceNames["dynamodb"] = "DynamoDB"
-	awsServiceNames["ec2"] = "EC2"
-	awsServiceNames["ecs"] = "ECS"
-}
-
 func awsServiceName(s string) (string, error) {
 	s = strings.ToLower(s)
 
@@ -326,6 +330,29 @@ func awsServiceName(s string) (string, error) {
 		return s, nil
 	}
 
+	switch s {
+	case "amp":
+		return "prometheusservice", nil
+	case "cloudcontrol":
+		return "cloudcontrolapi", nil
+	case "cognitoidp":
+		return "cognitoidentityprovider", nil
+	case "dms":
+		return "databasemigrationservice", nil
+	case "ds":
+		return "directoryservice", nil
+	case "events":
+		return "eventbridge", nil
+	case "lexmodels":
+		return "lexmodelbuildingservice", nil
+	case "serverlessrepo":
+		return "serverlessapplicationrepository", nil
+	}
+
+	if _, ok := awsServiceNames[fmt.Sprintf("%sservice", s)]; ok {
+		return fmt.Sprintf("%sservice", s), nil
+	}
+
 	return "", fmt.Errorf("unable to find AWS service name for %s", s)
 }
 
@@ -336,5 +363,341 @@ func awsServiceNameUpper(s string) (string, error) {
 		return v, nil
 	}
 
+	switch s {
+	case "amp":
+		return awsServiceNames["prometheusservice"], nil
+	case "appautoscaling":
+		return awsServiceNames["applicationautoscaling"], nil
+	case "cloudcontrol":
+		return awsServiceNames["cloudcontrolapi"], nil
+	case "cognitoidp":
+		return awsServiceNames["cognitoidentityprovider"], nil
+	case "dms":
+		return awsServiceNames["databasemigrationservice"], nil
+	case "ds":
+		return awsServiceNames["directoryservice"], nil
+	case "events":
+		return awsServiceNames["eventbridge"], nil
+	case "lexmodels":
+		return awsServiceNames["lexmodelbuildingservice"], nil
+	case "serverlessrepo":
+		return awsServiceNames["serverlessapplicationrepository"], nil
+	}
+
+	if v, ok := awsServiceNames[fmt.Sprintf("%sservice", s)]; ok {
+		return v, nil
+	}
+
 	return "", fmt.Errorf("unable to find AWS service name for %s", s)
 }
+
+// awsClientConnNames provides the AWSClient connection function names that
+// differ from the AWS SDK client names, e.g. CognitoIDPConn()
+var awsClientConnNames = map[string]string{
+	"apigatewayv2":  "APIGatewayV2",
+	"cognitoidp":    "CognitoIDP",
+	"dms":           "DMS",
+	"ds":            "DS",
+	"elasticsearch": "Elasticsearch",
+	"events":        "Events",
+	"imagebuilder":  "ImageBuilder",
+}
+
+func awsClientConnName(s string) (string, error) {
+	s = strings.ToLower(s)
+
+	if v, ok := awsClientConnNames[s]; ok {
+		return v, nil
+	}
+
+	return awsServiceNameUpper(s)
+}
+
+// awsServiceNames provides correct names and capitalization as used by AWS in client var
+var awsServiceNames map[string]string
+
+func init() {
+	awsServiceNames = make(map[string]string)
+
+	awsServiceNames["accessanalyzer"] = "AccessAnalyzer"
+	awsServiceNames["acm"] = "ACM"
+	awsServiceNames["acmpca"] = "ACMPCA"
+	awsServiceNames["alexaforbusiness"] = "AlexaForBusiness"
+	awsServiceNames["amplify"] = "Amplify"
+	awsServiceNames["amplifybackend"] = "AmplifyBackend"
+	awsServiceNames["apigateway"] = "APIGateway"
+	awsServiceNames["apigatewaymanagement"] = "APIGatewayManagement"
+	awsServiceNames["apigatewayv2"] = "APIGatewayV2"
+	awsServiceNames["apigatewayv2"] = "ApiGatewayV2"
+	awsServiceNames["appconfig"] = "AppConfig"
+	awsServiceNames["appflow"] = "AppFlow"
+	awsServiceNames["appintegrations"] = "AppIntegrations"
+	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
+	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
+	awsServiceNames["applicationdiscovery"] = "ApplicationDiscovery"
+	awsServiceNames["applicationinsights"] = "ApplicationInsights"
+	awsServiceNames["appmesh"] = "AppMesh"
+	awsServiceNames["appregistry"] = "AppRegistry"
+	awsServiceNames["apprunner"] = "AppRunner"
+	awsServiceNames["appstream"] = "AppStream"
+	awsServiceNames["appsync"] = "AppSync"
+	awsServiceNames["athena"] = "Athena"
+	awsServiceNames["auditmanager"] = "AuditManager"
+	awsServiceNames["augmentedairuntime"] = "AugmentedAiruntime"
+	awsServiceNames["autoscaling"] = "AutoScaling"
+	awsServiceNames["autoscalingplans"] = "AutoScalingPlans"
+	awsServiceNames["backup"] = "Backup"
+	awsServiceNames["batch"] = "Batch"
+	awsServiceNames["braket"] = "Braket"
+	awsServiceNames["budgets"] = "Budgets"
+	awsServiceNames["chime"] = "Chime"
+	awsServiceNames["cloud9"] = "Cloud9"
+	awsServiceNames["cloudcontrolapi"] = "CloudControlApi"
+	awsServiceNames["clouddirectory"] = "CloudDirectory"
+	awsServiceNames["cloudformation"] = "CloudFormation"
+	awsServiceNames["cloudfront"] = "CloudFront"
+	awsServiceNames["cloudhsm"] = "CloudHSM"
+	awsServiceNames["cloudhsmv2"] = "CloudHSMV2"
+	awsServiceNames["cloudsearch"] = "CloudSearch"
+	awsServiceNames["cloudsearchdomain"] = "CloudSearchDomain"
+	awsServiceNames["cloudtrail"] = "CloudTrail"
+	awsServiceNames["cloudwatch"] = "CloudWatch"
+	awsServiceNames["cloudwatchlogs"] = "CloudWatchLogs"
+	awsServiceNames["codeartifact"] = "CodeArtifact"
+	awsServiceNames["codebuild"] = "CodeBuild"
+	awsServiceNames["codecommit"] = "CodeCommit"
+	awsServiceNames["codedeploy"] = "CodeDeploy"
+	awsServiceNames["codeguruprofiler"] = "CodeGuruProfiler"
+	awsServiceNames["codegurureviewer"] = "CodeGuruReviewer"
+	awsServiceNames["codepipeline"] = "CodePipeline"
+	awsServiceNames["codestar"] = "CodeStar"
+	awsServiceNames["codestarconnections"] = "CodeStarConnections"
+	awsServiceNames["codestarnotifications"] = "CodeStarNotifications"
+	awsServiceNames["cognitoidentity"] = "CognitoIdentity"
+	awsServiceNames["cognitoidentityprovider"] = "CognitoIdentityProvider"
+	awsServiceNames["cognitosync"] = "CognitoSync"
+	awsServiceNames["comprehend"] = "Comprehend"
+	awsServiceNames["comprehendmedical"] = "ComprehendMedical"
+	awsServiceNames["computeoptimizer"] = "ComputeOptimizer"
+	awsServiceNames["configservice"] = "ConfigService"
+	awsServiceNames["connect"] = "Connect"
+	awsServiceNames["connectcontactlens"] = "ConnectContactLens"
+	awsServiceNames["connectparticipant"] = "ConnectParticipant"
+	awsServiceNames["costexplorer"] = "CostExplorer"
+	awsServiceNames["cur"] = "CUR"
+	awsServiceNames["customerprofiles"] = "CustomerProfiles"
+	awsServiceNames["databasemigrationservice"] = "DatabaseMigrationService"
+	awsServiceNames["dataexchange"] = "DataExchange"
+	awsServiceNames["datapipeline"] = "DataPipeline"
+	awsServiceNames["datasync"] = "DataSync"
+	awsServiceNames["dax"] = "DAX"
+	awsServiceNames["detective"] = "Detective"
+	awsServiceNames["devicefarm"] = "DeviceFarm"
+	awsServiceNames["devopsguru"] = "DevOpsGuru"
+	awsServiceNames["directconnect"] = "DirectConnect"
+	awsServiceNames["directoryservice"] = "DirectoryService"
+	awsServiceNames["dlm"] = "DLM"
+	awsServiceNames["docdb"] = "DocDB"
+	awsServiceNames["dynamodb"] = "DynamoDB"
+	awsServiceNames["dynamodbattribute"] = "DynamoDBAttribute"
+	awsServiceNames["dynamodbstreams"] = "DynamoDBStreams"
+	awsServiceNames["ec2"] = "EC2"
+	awsServiceNames["ec2instanceconnect"] = "EC2InstanceConnect"
+	awsServiceNames["ecr"] = "ECR"
+	awsServiceNames["ecrpublic"] = "ECRPublic"
+	awsServiceNames["ecs"] = "ECS"
+	awsServiceNames["efs"] = "EFS"
+	awsServiceNames["eks"] = "EKS"
+	awsServiceNames["elasticache"] = "ElastiCache"
+	awsServiceNames["elasticbeanstalk"] = "ElasticBeanstalk"
+	awsServiceNames["elasticinference"] = "ElasticInference"
+	awsServiceNames["elasticsearchservice"] = "ElasticsearchService"
+	awsServiceNames["elastictranscoder"] = "ElasticTranscoder"
+	awsServiceNames["elb"] = "ELB"
+	awsServiceNames["elbv2"] = "ELBV2"
+	awsServiceNames["emr"] = "EMR"
+	awsServiceNames["emrcontainers"] = "EMRContainers"
+	awsServiceNames["eventbridge"] = "EventBridge"
+	awsServiceNames["expression"] = "Expression"
+	awsServiceNames["finspace"] = "FinSpace"
+	awsServiceNames["finspacedata"] = "FinSpaceData"
+	awsServiceNames["firehose"] = "Firehose"
+	awsServiceNames["fis"] = "FIS"
+	awsServiceNames["fms"] = "FMS"
+	awsServiceNames["forecast"] = "Forecast"
+	awsServiceNames["forecastquery"] = "ForecastQuery"
+	awsServiceNames["frauddetector"] = "FraudDetector"
+	awsServiceNames["fsx"] = "FSx"
+	awsServiceNames["gamelift"] = "GameLift"
+	awsServiceNames["glacier"] = "Glacier"
+	awsServiceNames["globalaccelerator"] = "GlobalAccelerator"
+	awsServiceNames["glue"] = "Glue"
+	awsServiceNames["gluedatabrew"] = "GlueDataBrew"
+	awsServiceNames["greengrass"] = "Greengrass"
+	awsServiceNames["greengrassv2"] = "GreengrassV2"
+	awsServiceNames["groundstation"] = "GroundStation"
+	awsServiceNames["guardduty"] = "GuardDuty"
+	awsServiceNames["health"] = "Health"
+	awsServiceNames["healthlake"] = "HealthLake"
+	awsServiceNames["honeycode"] = "HoneyCode"
+	awsServiceNames["iam"] = "IAM"
+	awsServiceNames["identitystore"] = "IdentityStore"
+	awsServiceNames["imagebuilder"] = "ImageBuilder"
+	awsServiceNames["imagebuilder"] = "Imagebuilder"
+	awsServiceNames["inspector"] = "Inspector"
+	awsServiceNames["iot"] = "IoT"
+	awsServiceNames["iot1clickdevices"] = "IoT1ClickDevices"
+	awsServiceNames["iot1clickprojects"] = "IoT1ClickProjects"
+	awsServiceNames["iotanalytics"] = "IoTAnalytics"
+	awsServiceNames["iotdataplane"] = "IoTDataPlane"
+	awsServiceNames["iotdeviceadvisor"] = "IoTDeviceAdvisor"
+	awsServiceNames["iotevents"] = "IoTEvents"
+	awsServiceNames["ioteventsdata"] = "IoTEventsData"
+	awsServiceNames["iotfleethub"] = "IoTFleetHub"
+	awsServiceNames["iotjobsdataplane"] = "IoTJobsDataPlane"
+	awsServiceNames["iotsecuretunneling"] = "IoTSecureTunneling"
+	awsServiceNames["iotsitewise"] = "IoTSiteWise"
+	awsServiceNames["iotthingsgraph"] = "IoTThingsGraph"
+	awsServiceNames["iotwireless"] = "IoTWireless"
+	awsServiceNames["ivs"] = "IVS"
+	awsServiceNames["kafka"] = "Kafka"
+	awsServiceNames["kendra"] = "Kendra"
+	awsServiceNames["kinesis"] = "Kinesis"
+	awsServiceNames["kinesisanalytics"] = "KinesisAnalytics"
+	awsServiceNames["kinesisanalyticsv2"] = "KinesisAnalyticsV2"
+	awsServiceNames["kinesisvideo"] = "KinesisVideo"
+	awsServiceNames["kinesisvideoarchivedmedia"] = "KinesisVideoArchivedMedia"
+	awsServiceNames["kinesisvideomedia"] = "KinesisVideoMedia"
+	awsServiceNames["kinesisvideosignalingchannels"] = "KinesisVideoSignalingChannels"
+	awsServiceNames["kms"] = "KMS"
+	awsServiceNames["lakeformation"] = "LakeFormation"
+	awsServiceNames["lambda"] = "Lambda"
+	awsServiceNames["lexmodelbuildingservice"] = "LexModelBuildingService"
+	awsServiceNames["lexmodelsv2"] = "LexModelsV2"
+	awsServiceNames["lexruntime"] = "LexRuntime"
+	awsServiceNames["lexruntimev2"] = "LexRuntimeV2"
+	awsServiceNames["licensemanager"] = "LicenseManager"
+	awsServiceNames["lightsail"] = "Lightsail"
+	awsServiceNames["location"] = "Location"
+	awsServiceNames["lookoutequipment"] = "LookoutEquipment"
+	awsServiceNames["lookoutforvision"] = "LookoutForVision"
+	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
+	awsServiceNames["machinelearning"] = "MachineLearning"
+	awsServiceNames["macie"] = "Macie"
+	awsServiceNames["macie2"] = "Macie2"
+	awsServiceNames["managedblockchain"] = "ManagedBlockchain"
+	awsServiceNames["marketplacecatalog"] = "MarketplaceCatalog"
+	awsServiceNames["marketplacecommerceanalytics"] = "MarketplaceCommerceAnalytics"
+	awsServiceNames["marketplaceentitlement"] = "MarketplaceEntitlement"
+	awsServiceNames["marketplacemetering"] = "MarketplaceMetering"
+	awsServiceNames["mediaconnect"] = "MediaConnect"
+	awsServiceNames["mediaconvert"] = "MediaConvert"
+	awsServiceNames["medialive"] = "MediaLive"
+	awsServiceNames["mediapackage"] = "MediaPackage"
+	awsServiceNames["mediapackagevod"] = "MediaPackageVOD"
+	awsServiceNames["mediastore"] = "MediaStore"
+	awsServiceNames["mediastoredata"] = "MediaStoreData"
+	awsServiceNames["mediatailor"] = "MediaTailor"
+	awsServiceNames["memorydb"] = "MemoryDB"
+	awsServiceNames["mgn"] = "Mgn"
+	awsServiceNames["migrationhub"] = "MigrationHub"
+	awsServiceNames["migrationhubconfig"] = "MigrationHubConfig"
+	awsServiceNames["mobile"] = "Mobile"
+	awsServiceNames["mobileanalytics"] = "MobileAnalytics"
+	awsServiceNames["mq"] = "MQ"
+	awsServiceNames["mturk"] = "MTurk"
+	awsServiceNames["mwaa"] = "MWAA"
+	awsServiceNames["neptune"] = "Neptune"
+	awsServiceNames["networkfirewall"] = "NetworkFirewall"
+	awsServiceNames["networkmanager"] = "NetworkManager"
+	awsServiceNames["nimblestudio"] = "NimbleStudio"
+	awsServiceNames["opsworks"] = "OpsWorks"
+	awsServiceNames["opsworkscm"] = "OpsWorksCM"
+	awsServiceNames["organizations"] = "Organizations"
+	awsServiceNames["outposts"] = "Outposts"
+	awsServiceNames["personalize"] = "Personalize"
+	awsServiceNames["personalizeevents"] = "PersonalizeEvents"
+	awsServiceNames["personalizeruntime"] = "PersonalizeRuntime"
+	awsServiceNames["pi"] = "PI"
+	awsServiceNames["pinpoint"] = "Pinpoint"
+	awsServiceNames["pinpointemail"] = "PinpointEmail"
+	awsServiceNames["pinpointsmsvoice"] = "PinpointSMSVoice"
+	awsServiceNames["polly"] = "Polly"
+	awsServiceNames["pricing"] = "Pricing"
+	awsServiceNames["prometheusservice"] = "PrometheusService"
+	awsServiceNames["proton"] = "Proton"
+	awsServiceNames["qldb"] = "QLDB"
+	awsServiceNames["qldbsession"] = "QLDBSession"
+	awsServiceNames["quicksight"] = "QuickSight"
+	awsServiceNames["ram"] = "RAM"
+	awsServiceNames["rds"] = "RDS"
+	awsServiceNames["rdsdata"] = "RDSData"
+	awsServiceNames["rdsutils"] = "RDSUtils"
+	awsServiceNames["redshift"] = "Redshift"
+	awsServiceNames["redshiftdata"] = "RedshiftData"
+	awsServiceNames["rekognition"] = "Rekognition"
+	awsServiceNames["resourcegroups"] = "ResourceGroups"
+	awsServiceNames["resourcegroupstaggingapi"] = "ResourceGroupsTaggingAPI"
+	awsServiceNames["robomaker"] = "RoboMaker"
+	awsServiceNames["route53"] = "Route53"
+	awsServiceNames["route53domains"] = "Route53Domains"
+	awsServiceNames["route53recoverycontrolconfig"] = "Route53RecoveryControlConfig"
+	awsServiceNames["route53recoveryreadiness"] = "Route53RecoveryReadiness"
+	awsServiceNames["route53resolver"] = "Route53Resolver"
+	awsServiceNames["s3"] = "S3"
+	awsServiceNames["s3control"] = "S3Control"
+	awsServiceNames["s3crypto"] = "S3Crypto"
+	awsServiceNames["s3manager"] = "S3Manager"
+	awsServiceNames["s3outposts"] = "S3Outposts"
+	awsServiceNames["sagemaker"] = "SageMaker"
+	awsServiceNames["sagemakeredgemanager"] = "SageMakerEdgeManager"
+	awsServiceNames["sagemakerfeaturestoreruntime"] = "SageMakerFeatureStoreRuntime"
+	awsServiceNames["sagemakerruntime"] = "SageMakerRuntime"
+	awsServiceNames["savingsplans"] = "SavingsPlans"
+	awsServiceNames["schemas"] = "Schemas"
+	awsServiceNames["secretsmanager"] = "SecretsManager"
+	awsServiceNames["securityhub"] = "SecurityHub"
+	awsServiceNames["serverlessapplicationrepository"] = "ServerlessApplicationRepository"
+	awsServiceNames["servicecatalog"] = "ServiceCatalog"
+	awsServiceNames["servicediscovery"] = "ServiceDiscovery"
+	awsServiceNames["servicequotas"] = "ServiceQuotas"
+	awsServiceNames["ses"] = "SES"
+	awsServiceNames["sesv2"] = "SESV2"
+	awsServiceNames["sfn"] = "SFN"
+	awsServiceNames["shield"] = "Shield"
+	awsServiceNames["sign"] = "Sign"
+	awsServiceNames["signer"] = "Signer"
+	awsServiceNames["simpledb"] = "SimpleDB"
+	awsServiceNames["sms"] = "SMS"
+	awsServiceNames["snowball"] = "Snowball"
+	awsServiceNames["sns"] = "SNS"
+	awsServiceNames["sqs"] = "SQS"
+	awsServiceNames["ssm"] = "SSM"
+	awsServiceNames["ssmcontacts"] = "SSMContacts"
+	awsServiceNames["ssmincidents"] = "SSMIncidents"
+	awsServiceNames["sso"] = "SSO"
+	awsServiceNames["ssoadmin"] = "SSOAdmin"
+	awsServiceNames["ssooidc"] = "SSOOIDC"
+	awsServiceNames["storagegateway"] = "StorageGateway"
+	awsServiceNames["sts"] = "STS"
+	awsServiceNames["support"] = "Support"
+	awsServiceNames["swf"] = "SWF"
+	awsServiceNames["synthetics"] = "Synthetics"
+	awsServiceNames["textract"] = "Textract"
+	awsServiceNames["timestreamquery"] = "TimestreamQuery"
+	awsServiceNames["timestreamwrite"] = "TimestreamWrite"
+	awsServiceNames["transcribe"] = "Transcribe"
+	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
+	awsServiceNames["transfer"] = "Transfer"
+	awsServiceNames["translate"] = "Translate"
+	awsServiceNames["waf"] = "WAF"
+	awsServiceNames["wafregional"] = "WAFRegional"
+	awsServiceNames["wafv2"] = "WAFV2"
+	awsServiceNames["wellarchitected"] = "WellArchitected"
+	awsServiceNames["workdocs"] = "WorkDocs"
+	awsServiceNames["worklink"] = "WorkLink"
+	awsServiceNames["workmail"] = "WorkMail"
+	awsServiceNames["workmailmessageflow"] = "WorkMailMessageFlow"
+	awsServiceNames["workspaces"] = "WorkSpaces"
+	awsServiceNames["xray"] = "XRay"
+}
@@ -49,7 +49,7 @@ var (
 	untagInTagsElem       = flag.String("UntagInTagsElem", "TagKeys", "untagInTagsElem")
 	untagOp               = flag.String("UntagOp", "UntagResource", "untagOp")
 
-	parentNotFoundErrCode = flag.String("ParentNotFoundErrCode", "", "Parent 'NotFound' Error Code")
+	parentNotFoundErrCode = flag.String("ParentNotFoundErrCode", "", "Parent 'NotFound' Error Code(s), comma separated")
 	parentNotFoundErrMsg  = flag.String("ParentNotFoundErrMsg", "", "Parent 'NotFound' Error Message")
 )
 
@@ -71,6 +71,7 @@ type TemplateData struct {
 	ListTagsOp              string
 	ListTagsOutTagsElem     string
 	ParentNotFoundErrCode   string
+	ParentNotFoundErrCodes  []string
 	ParentNotFoundErrMsg    string
 	RetryCreateOnNotFound   string
 	TagInCustomVal          string
@@ -145,6 +146,7 @@ func main() {
 		ListTagsOp:              *listTagsOp,
 		ListTagsOutTagsElem:     *listTagsOutTagsElem,
 		ParentNotFoundErrCode:   *parentNotFoundErrCode,
+		ParentNotFoundErrCodes:  strings.Split(*parentNotFoundErrCode, ","),
 		ParentNotFoundErrMsg:    *parentNotFoundErrMsg,
 		TagInCustomVal:          *tagInCustomVal,
 		TagInIDElem:             *tagInIDElem,
@@ -353,7 +355,7 @@ func ListTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }},
 				}
 			}
 	{{- else if ( .ParentNotFoundErrCode ) }}
-			if tfawserr.ErrCodeEquals(err, "{{ .ParentNotFoundErrCode }}") {
+			if tfawserr.ErrCodeEquals(err, {{ range $i, $code := .ParentNotFoundErrCodes }}{{ if $i }}, {{ end }}"{{ $code }}"{{ end }}) {
 				return nil, &resource.NotFoundError{
 					LastError:   err,
 					LastRequest: input,
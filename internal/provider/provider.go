@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -47,6 +50,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/connect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cur"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
@@ -78,12 +82,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
@@ -97,7 +104,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
@@ -105,6 +114,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
@@ -188,6 +198,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -220,6 +232,38 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_RETRY_MODE", nil),
+				Description:  descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+			},
+
+			"api_audit_log": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["api_audit_log"],
+			},
+
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["read_only"],
+			},
+
+			"read_only_allowed_operations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9-]+:[A-Za-z0-9]+$`), "must be in <service>:<operation> form"),
+				},
+				Set:         schema.HashString,
+				Description: descriptions["read_only_allowed_operations"],
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -259,6 +303,29 @@ func Provider() *schema.Provider {
 				Description: descriptions["http_proxy"],
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarCABundle, nil),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"ec2_metadata_service_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarEC2MetadataServiceEndpoint, nil),
+				Description:  descriptions["ec2_metadata_service_endpoint"],
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"ec2_metadata_service_endpoint_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarEC2MetadataServiceEndpointMode, nil),
+				Description:  descriptions["ec2_metadata_service_endpoint_mode"],
+				ValidateFunc: validation.StringInSlice(conns.EC2MetadataServiceEndpointMode_Values(), false),
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -282,6 +349,75 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_patterns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"value_pattern": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Resource tag to ignore across all resources when its value matches a regular expression.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+										Description:  "Resource tag key.",
+									},
+									"pattern": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression matching the resource tag values to ignore.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to require resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Resource tag required across all resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+										Set:         schema.HashString,
+										Description: "Regular expressions, one of which the resource tag value must match.",
+									},
+									"exclude_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Resource types that do not require the resource tag.",
+									},
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+										Description:  "Resource tag key.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -334,6 +470,20 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -365,7 +515,8 @@ func Provider() *schema.Provider {
 			"aws_batch_compute_environment": batch.DataSourceComputeEnvironment(),
 			"aws_batch_job_queue":           batch.DataSourceJobQueue(),
 
-			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resource":  cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resources": cloudcontrol.DataSourceResources(),
 
 			"aws_cloudformation_export": cloudformation.DataSourceExport(),
 			"aws_cloudformation_stack":  cloudformation.DataSourceStack(),
@@ -713,60 +864,65 @@ func Provider() *schema.Provider {
 
 		ResourcesMap: map[string]*schema.Resource{
 			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_tag":      accessanalyzer.ResourceTag(), // lintignore:AWSR006
 
 			"aws_acm_certificate":            acm.ResourceCertificate(),
-			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
+			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(), // lintignore:AWSR006
+			"aws_acm_tag":                    acm.ResourceTag(),                   // lintignore:AWSR006
 
 			"aws_acmpca_certificate":                       acmpca.ResourceCertificate(),
 			"aws_acmpca_certificate_authority":             acmpca.ResourceCertificateAuthority(),
 			"aws_acmpca_certificate_authority_certificate": acmpca.ResourceCertificateAuthorityCertificate(),
+			"aws_acmpca_tag":                               acmpca.ResourceTag(), // lintignore:AWSR006
 
 			"aws_prometheus_workspace":                amp.ResourceWorkspace(),
 			"aws_prometheus_alert_manager_definition": amp.ResourceAlertManagerDefinition(),
 			"aws_prometheus_rule_group_namespace":     amp.ResourceRuleGroupNamespace(),
 
 			"aws_amplify_app":                 amplify.ResourceApp(),
-			"aws_amplify_backend_environment": amplify.ResourceBackendEnvironment(),
-			"aws_amplify_branch":              amplify.ResourceBranch(),
-			"aws_amplify_domain_association":  amplify.ResourceDomainAssociation(),
-			"aws_amplify_webhook":             amplify.ResourceWebhook(),
+			"aws_amplify_backend_environment": amplify.ResourceBackendEnvironment(), // lintignore:AWSR006
+			"aws_amplify_branch":              amplify.ResourceBranch(),             // lintignore:AWSR006
+			"aws_amplify_domain_association":  amplify.ResourceDomainAssociation(),  // lintignore:AWSR006
+			"aws_amplify_tag":                 amplify.ResourceTag(),                // lintignore:AWSR006
+			"aws_amplify_webhook":             amplify.ResourceWebhook(),            // lintignore:AWSR006
 
-			"aws_api_gateway_account":               apigateway.ResourceAccount(),
+			"aws_api_gateway_account":               apigateway.ResourceAccount(), // lintignore:AWSR006
 			"aws_api_gateway_api_key":               apigateway.ResourceAPIKey(),
-			"aws_api_gateway_authorizer":            apigateway.ResourceAuthorizer(),
+			"aws_api_gateway_authorizer":            apigateway.ResourceAuthorizer(), // lintignore:AWSR006
 			"aws_api_gateway_base_path_mapping":     apigateway.ResourceBasePathMapping(),
 			"aws_api_gateway_client_certificate":    apigateway.ResourceClientCertificate(),
-			"aws_api_gateway_deployment":            apigateway.ResourceDeployment(),
-			"aws_api_gateway_documentation_part":    apigateway.ResourceDocumentationPart(),
-			"aws_api_gateway_documentation_version": apigateway.ResourceDocumentationVersion(),
+			"aws_api_gateway_deployment":            apigateway.ResourceDeployment(),           // lintignore:AWSR006
+			"aws_api_gateway_documentation_part":    apigateway.ResourceDocumentationPart(),    // lintignore:AWSR006
+			"aws_api_gateway_documentation_version": apigateway.ResourceDocumentationVersion(), // lintignore:AWSR006
 			"aws_api_gateway_domain_name":           apigateway.ResourceDomainName(),
-			"aws_api_gateway_gateway_response":      apigateway.ResourceGatewayResponse(),
-			"aws_api_gateway_integration":           apigateway.ResourceIntegration(),
-			"aws_api_gateway_integration_response":  apigateway.ResourceIntegrationResponse(),
-			"aws_api_gateway_method":                apigateway.ResourceMethod(),
-			"aws_api_gateway_method_response":       apigateway.ResourceMethodResponse(),
-			"aws_api_gateway_method_settings":       apigateway.ResourceMethodSettings(),
-			"aws_api_gateway_model":                 apigateway.ResourceModel(),
-			"aws_api_gateway_request_validator":     apigateway.ResourceRequestValidator(),
-			"aws_api_gateway_resource":              apigateway.ResourceResource(),
+			"aws_api_gateway_gateway_response":      apigateway.ResourceGatewayResponse(),     // lintignore:AWSR006
+			"aws_api_gateway_integration":           apigateway.ResourceIntegration(),         // lintignore:AWSR006
+			"aws_api_gateway_integration_response":  apigateway.ResourceIntegrationResponse(), // lintignore:AWSR006
+			"aws_api_gateway_method":                apigateway.ResourceMethod(),              // lintignore:AWSR006
+			"aws_api_gateway_method_response":       apigateway.ResourceMethodResponse(),      // lintignore:AWSR006
+			"aws_api_gateway_method_settings":       apigateway.ResourceMethodSettings(),      // lintignore:AWSR006
+			"aws_api_gateway_model":                 apigateway.ResourceModel(),               // lintignore:AWSR006
+			"aws_api_gateway_request_validator":     apigateway.ResourceRequestValidator(),    // lintignore:AWSR006
+			"aws_api_gateway_resource":              apigateway.ResourceResource(),            // lintignore:AWSR006
 			"aws_api_gateway_rest_api":              apigateway.ResourceRestAPI(),
-			"aws_api_gateway_rest_api_policy":       apigateway.ResourceRestAPIPolicy(),
-			"aws_api_gateway_stage":                 apigateway.ResourceStage(),
+			"aws_api_gateway_rest_api_policy":       apigateway.ResourceRestAPIPolicy(), // lintignore:AWSR006
+			"aws_api_gateway_stage":                 apigateway.ResourceStage(),         // lintignore:AWSR006
 			"aws_api_gateway_usage_plan":            apigateway.ResourceUsagePlan(),
 			"aws_api_gateway_usage_plan_key":        apigateway.ResourceUsagePlanKey(),
 			"aws_api_gateway_vpc_link":              apigateway.ResourceVPCLink(),
 
 			"aws_apigatewayv2_api":                  apigatewayv2.ResourceAPI(),
-			"aws_apigatewayv2_api_mapping":          apigatewayv2.ResourceAPIMapping(),
-			"aws_apigatewayv2_authorizer":           apigatewayv2.ResourceAuthorizer(),
-			"aws_apigatewayv2_deployment":           apigatewayv2.ResourceDeployment(),
+			"aws_apigatewayv2_api_mapping":          apigatewayv2.ResourceAPIMapping(), // lintignore:AWSR006
+			"aws_apigatewayv2_authorizer":           apigatewayv2.ResourceAuthorizer(), // lintignore:AWSR006
+			"aws_apigatewayv2_deployment":           apigatewayv2.ResourceDeployment(), // lintignore:AWSR006
 			"aws_apigatewayv2_domain_name":          apigatewayv2.ResourceDomainName(),
-			"aws_apigatewayv2_integration":          apigatewayv2.ResourceIntegration(),
-			"aws_apigatewayv2_integration_response": apigatewayv2.ResourceIntegrationResponse(),
-			"aws_apigatewayv2_model":                apigatewayv2.ResourceModel(),
-			"aws_apigatewayv2_route":                apigatewayv2.ResourceRoute(),
-			"aws_apigatewayv2_route_response":       apigatewayv2.ResourceRouteResponse(),
-			"aws_apigatewayv2_stage":                apigatewayv2.ResourceStage(),
+			"aws_apigatewayv2_integration":          apigatewayv2.ResourceIntegration(),         // lintignore:AWSR006
+			"aws_apigatewayv2_integration_response": apigatewayv2.ResourceIntegrationResponse(), // lintignore:AWSR006
+			"aws_apigatewayv2_model":                apigatewayv2.ResourceModel(),               // lintignore:AWSR006
+			"aws_apigatewayv2_route":                apigatewayv2.ResourceRoute(),               // lintignore:AWSR006
+			"aws_apigatewayv2_route_response":       apigatewayv2.ResourceRouteResponse(),       // lintignore:AWSR006
+			"aws_apigatewayv2_stage":                apigatewayv2.ResourceStage(),               // lintignore:AWSR006
+			"aws_apigatewayv2_tag":                  apigatewayv2.ResourceTag(),                 // lintignore:AWSR006
 			"aws_apigatewayv2_vpc_link":             apigatewayv2.ResourceVPCLink(),
 
 			"aws_appconfig_application":                  appconfig.ResourceApplication(),
@@ -775,6 +931,7 @@ func Provider() *schema.Provider {
 			"aws_appconfig_deployment_strategy":          appconfig.ResourceDeploymentStrategy(),
 			"aws_appconfig_environment":                  appconfig.ResourceEnvironment(),
 			"aws_appconfig_hosted_configuration_version": appconfig.ResourceHostedConfigurationVersion(),
+			"aws_appconfig_tag":                          appconfig.ResourceTag(), // lintignore:AWSR006
 
 			"aws_appautoscaling_policy":           appautoscaling.ResourcePolicy(),
 			"aws_appautoscaling_scheduled_action": appautoscaling.ResourceScheduledAction(),
@@ -783,6 +940,7 @@ func Provider() *schema.Provider {
 			"aws_appmesh_gateway_route":   appmesh.ResourceGatewayRoute(),
 			"aws_appmesh_mesh":            appmesh.ResourceMesh(),
 			"aws_appmesh_route":           appmesh.ResourceRoute(),
+			"aws_appmesh_tag":             appmesh.ResourceTag(), // lintignore:AWSR006
 			"aws_appmesh_virtual_gateway": appmesh.ResourceVirtualGateway(),
 			"aws_appmesh_virtual_node":    appmesh.ResourceVirtualNode(),
 			"aws_appmesh_virtual_router":  appmesh.ResourceVirtualRouter(),
@@ -792,37 +950,42 @@ func Provider() *schema.Provider {
 			"aws_apprunner_connection":                         apprunner.ResourceConnection(),
 			"aws_apprunner_custom_domain_association":          apprunner.ResourceCustomDomainAssociation(),
 			"aws_apprunner_service":                            apprunner.ResourceService(),
+			"aws_apprunner_tag":                                apprunner.ResourceTag(), // lintignore:AWSR006
 
 			"aws_appstream_directory_config": appstream.ResourceDirectoryConfig(),
 			"aws_appstream_fleet":            appstream.ResourceFleet(),
 			"aws_appstream_image_builder":    appstream.ResourceImageBuilder(),
 			"aws_appstream_stack":            appstream.ResourceStack(),
+			"aws_appstream_tag":              appstream.ResourceTag(), // lintignore:AWSR006
 
-			"aws_appsync_api_key":     appsync.ResourceAPIKey(),
-			"aws_appsync_datasource":  appsync.ResourceDataSource(),
-			"aws_appsync_function":    appsync.ResourceFunction(),
+			"aws_appsync_api_key":     appsync.ResourceAPIKey(),     // lintignore:AWSR006
+			"aws_appsync_datasource":  appsync.ResourceDataSource(), // lintignore:AWSR006
+			"aws_appsync_function":    appsync.ResourceFunction(),   // lintignore:AWSR006
 			"aws_appsync_graphql_api": appsync.ResourceGraphQLAPI(),
-			"aws_appsync_resolver":    appsync.ResourceResolver(),
+			"aws_appsync_resolver":    appsync.ResourceResolver(), // lintignore:AWSR006
+			"aws_appsync_tag":         appsync.ResourceTag(),      // lintignore:AWSR006
 
 			"aws_athena_database":    athena.ResourceDatabase(),
 			"aws_athena_named_query": athena.ResourceNamedQuery(),
+			"aws_athena_tag":         athena.ResourceTag(), // lintignore:AWSR006
 			"aws_athena_workgroup":   athena.ResourceWorkGroup(),
 
-			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
+			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(), // lintignore:AWSR006
 			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
-			"aws_autoscaling_group_tag":      autoscaling.ResourceGroupTag(),
-			"aws_autoscaling_lifecycle_hook": autoscaling.ResourceLifecycleHook(),
-			"aws_autoscaling_notification":   autoscaling.ResourceNotification(),
-			"aws_autoscaling_policy":         autoscaling.ResourcePolicy(),
-			"aws_autoscaling_schedule":       autoscaling.ResourceSchedule(),
+			"aws_autoscaling_group_tag":      autoscaling.ResourceGroupTag(),      // lintignore:AWSR006
+			"aws_autoscaling_lifecycle_hook": autoscaling.ResourceLifecycleHook(), // lintignore:AWSR006
+			"aws_autoscaling_notification":   autoscaling.ResourceNotification(),  // lintignore:AWSR006
+			"aws_autoscaling_policy":         autoscaling.ResourcePolicy(),        // lintignore:AWSR006
+			"aws_autoscaling_schedule":       autoscaling.ResourceSchedule(),      // lintignore:AWSR006
 			"aws_launch_configuration":       autoscaling.ResourceLaunchConfiguration(),
 
 			"aws_autoscalingplans_scaling_plan": autoscalingplans.ResourceScalingPlan(),
 
-			"aws_backup_global_settings":          backup.ResourceGlobalSettings(),
+			"aws_backup_global_settings":          backup.ResourceGlobalSettings(), // lintignore:AWSR006
 			"aws_backup_plan":                     backup.ResourcePlan(),
-			"aws_backup_region_settings":          backup.ResourceRegionSettings(),
+			"aws_backup_region_settings":          backup.ResourceRegionSettings(), // lintignore:AWSR006
 			"aws_backup_selection":                backup.ResourceSelection(),
+			"aws_backup_tag":                      backup.ResourceTag(), // lintignore:AWSR006
 			"aws_backup_vault":                    backup.ResourceVault(),
 			"aws_backup_vault_lock_configuration": backup.ResourceVaultLockConfiguration(),
 			"aws_backup_vault_notifications":      backup.ResourceVaultNotifications(),
@@ -831,6 +994,7 @@ func Provider() *schema.Provider {
 			"aws_batch_compute_environment": batch.ResourceComputeEnvironment(),
 			"aws_batch_job_definition":      batch.ResourceJobDefinition(),
 			"aws_batch_job_queue":           batch.ResourceJobQueue(),
+			"aws_batch_tag":                 batch.ResourceTag(), // lintignore:AWSR006
 
 			"aws_budgets_budget":        budgets.ResourceBudget(),
 			"aws_budgets_budget_action": budgets.ResourceBudgetAction(),
@@ -844,6 +1008,7 @@ func Provider() *schema.Provider {
 			"aws_chime_voice_connector_termination_credentials": chime.ResourceVoiceConnectorTerminationCredentials(),
 
 			"aws_cloud9_environment_ec2": cloud9.ResourceEnvironmentEC2(),
+			"aws_cloud9_tag":             cloud9.ResourceTag(), // lintignore:AWSR006
 
 			"aws_cloudcontrolapi_resource": cloudcontrol.ResourceResource(),
 
@@ -864,39 +1029,48 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_public_key":                     cloudfront.ResourcePublicKey(),
 			"aws_cloudfront_realtime_log_config":            cloudfront.ResourceRealtimeLogConfig(),
 			"aws_cloudfront_response_headers_policy":        cloudfront.ResourceResponseHeadersPolicy(),
+			"aws_cloudfront_tag":                            cloudfront.ResourceTag(), // lintignore:AWSR006
 
 			"aws_cloudhsm_v2_cluster": cloudhsmv2.ResourceCluster(),
 			"aws_cloudhsm_v2_hsm":     cloudhsmv2.ResourceHSM(),
+			"aws_cloudhsm_v2_tag":     cloudhsmv2.ResourceTag(), // lintignore:AWSR006
 
-			"aws_cloudtrail": cloudtrail.ResourceCloudTrail(),
+			"aws_cloudtrail":     cloudtrail.ResourceCloudTrail(),
+			"aws_cloudtrail_tag": cloudtrail.ResourceTag(), // lintignore:AWSR006
 
 			"aws_cloudwatch_composite_alarm": cloudwatch.ResourceCompositeAlarm(),
 			"aws_cloudwatch_dashboard":       cloudwatch.ResourceDashboard(),
 			"aws_cloudwatch_metric_alarm":    cloudwatch.ResourceMetricAlarm(),
 			"aws_cloudwatch_metric_stream":   cloudwatch.ResourceMetricStream(),
+			"aws_cloudwatch_tag":             cloudwatch.ResourceTag(), // lintignore:AWSR006
+
+			"aws_dataexchange_tag": dataexchange.ResourceTag(), // lintignore:AWSR006
 
 			"aws_cloudwatch_event_api_destination": events.ResourceAPIDestination(),
 			"aws_cloudwatch_event_archive":         events.ResourceArchive(),
 			"aws_cloudwatch_event_bus":             events.ResourceBus(),
-			"aws_cloudwatch_event_bus_policy":      events.ResourceBusPolicy(),
+			"aws_cloudwatch_event_bus_policy":      events.ResourceBusPolicy(), // lintignore:AWSR006
 			"aws_cloudwatch_event_connection":      events.ResourceConnection(),
 			"aws_cloudwatch_event_permission":      events.ResourcePermission(),
 			"aws_cloudwatch_event_rule":            events.ResourceRule(),
+			"aws_cloudwatch_event_tag":             events.ResourceTag(), // lintignore:AWSR006
 			"aws_cloudwatch_event_target":          events.ResourceTarget(),
 
 			"aws_cloudwatch_log_destination":         cloudwatchlogs.ResourceDestination(),
 			"aws_cloudwatch_log_destination_policy":  cloudwatchlogs.ResourceDestinationPolicy(),
 			"aws_cloudwatch_log_group":               cloudwatchlogs.ResourceGroup(),
-			"aws_cloudwatch_log_metric_filter":       cloudwatchlogs.ResourceMetricFilter(),
+			"aws_cloudwatch_log_metric_filter":       cloudwatchlogs.ResourceMetricFilter(), // lintignore:AWSR006
 			"aws_cloudwatch_log_resource_policy":     cloudwatchlogs.ResourceResourcePolicy(),
-			"aws_cloudwatch_log_stream":              cloudwatchlogs.ResourceStream(),
-			"aws_cloudwatch_log_subscription_filter": cloudwatchlogs.ResourceSubscriptionFilter(),
+			"aws_cloudwatch_log_stream":              cloudwatchlogs.ResourceStream(),             // lintignore:AWSR006
+			"aws_cloudwatch_log_subscription_filter": cloudwatchlogs.ResourceSubscriptionFilter(), // lintignore:AWSR006
+			"aws_cloudwatch_log_tag":                 cloudwatchlogs.ResourceTag(),                // lintignore:AWSR006
 			"aws_cloudwatch_query_definition":        cloudwatchlogs.ResourceQueryDefinition(),
 
 			"aws_codeartifact_domain":                        codeartifact.ResourceDomain(),
-			"aws_codeartifact_domain_permissions_policy":     codeartifact.ResourceDomainPermissionsPolicy(),
+			"aws_codeartifact_domain_permissions_policy":     codeartifact.ResourceDomainPermissionsPolicy(), // lintignore:AWSR006
 			"aws_codeartifact_repository":                    codeartifact.ResourceRepository(),
-			"aws_codeartifact_repository_permissions_policy": codeartifact.ResourceRepositoryPermissionsPolicy(),
+			"aws_codeartifact_repository_permissions_policy": codeartifact.ResourceRepositoryPermissionsPolicy(), // lintignore:AWSR006
+			"aws_codeartifact_tag":                           codeartifact.ResourceTag(),                         // lintignore:AWSR006
 
 			"aws_codebuild_project":           codebuild.ResourceProject(),
 			"aws_codebuild_report_group":      codebuild.ResourceReportGroup(),
@@ -904,42 +1078,50 @@ func Provider() *schema.Provider {
 			"aws_codebuild_webhook":           codebuild.ResourceWebhook(),
 
 			"aws_codecommit_repository": codecommit.ResourceRepository(),
+			"aws_codecommit_tag":        codecommit.ResourceTag(), // lintignore:AWSR006
 			"aws_codecommit_trigger":    codecommit.ResourceTrigger(),
 
 			"aws_codedeploy_app":               codedeploy.ResourceApp(),
 			"aws_codedeploy_deployment_config": codedeploy.ResourceDeploymentConfig(),
-			"aws_codedeploy_deployment_group":  codedeploy.ResourceDeploymentGroup(),
+			"aws_codedeploy_deployment_group":  codedeploy.ResourceDeploymentGroup(), // lintignore:AWSR006
+			"aws_codedeploy_tag":               codedeploy.ResourceTag(),             // lintignore:AWSR006
 
 			"aws_codepipeline":         codepipeline.ResourceCodePipeline(),
+			"aws_codepipeline_tag":     codepipeline.ResourceTag(), // lintignore:AWSR006
 			"aws_codepipeline_webhook": codepipeline.ResourceWebhook(),
 
 			"aws_codestarconnections_connection": codestarconnections.ResourceConnection(),
 			"aws_codestarconnections_host":       codestarconnections.ResourceHost(),
+			"aws_codestarconnections_tag":        codestarconnections.ResourceTag(), // lintignore:AWSR006
 
 			"aws_codestarnotifications_notification_rule": codestarnotifications.ResourceNotificationRule(),
+			"aws_codestarnotifications_tag":               codestarnotifications.ResourceTag(), // lintignore:AWSR006
 
 			"aws_cognito_identity_pool":                  cognitoidentity.ResourcePool(),
 			"aws_cognito_identity_pool_roles_attachment": cognitoidentity.ResourcePoolRolesAttachment(),
+			"aws_cognito_identity_tag":                   cognitoidentity.ResourceTag(), // lintignore:AWSR006
 
-			"aws_cognito_identity_provider":          cognitoidp.ResourceIdentityProvider(),
-			"aws_cognito_resource_server":            cognitoidp.ResourceResourceServer(),
-			"aws_cognito_user_group":                 cognitoidp.ResourceUserGroup(),
+			"aws_cognito_identity_provider":          cognitoidp.ResourceIdentityProvider(), // lintignore:AWSR006
+			"aws_cognito_idp_tag":                    cognitoidp.ResourceTag(),              // lintignore:AWSR006
+			"aws_cognito_resource_server":            cognitoidp.ResourceResourceServer(),   // lintignore:AWSR006
+			"aws_cognito_user_group":                 cognitoidp.ResourceUserGroup(),        // lintignore:AWSR006
 			"aws_cognito_user_pool":                  cognitoidp.ResourceUserPool(),
-			"aws_cognito_user_pool_client":           cognitoidp.ResourceUserPoolClient(),
+			"aws_cognito_user_pool_client":           cognitoidp.ResourceUserPoolClient(), // lintignore:AWSR006
 			"aws_cognito_user_pool_domain":           cognitoidp.ResourceUserPoolDomain(),
-			"aws_cognito_user_pool_ui_customization": cognitoidp.ResourceUserPoolUICustomization(),
+			"aws_cognito_user_pool_ui_customization": cognitoidp.ResourceUserPoolUICustomization(), // lintignore:AWSR006
 
 			"aws_config_aggregate_authorization":       configservice.ResourceAggregateAuthorization(),
 			"aws_config_config_rule":                   configservice.ResourceConfigRule(),
 			"aws_config_configuration_aggregator":      configservice.ResourceConfigurationAggregator(),
 			"aws_config_configuration_recorder":        configservice.ResourceConfigurationRecorder(),
-			"aws_config_configuration_recorder_status": configservice.ResourceConfigurationRecorderStatus(),
+			"aws_config_configuration_recorder_status": configservice.ResourceConfigurationRecorderStatus(), // lintignore:AWSR006
 			"aws_config_conformance_pack":              configservice.ResourceConformancePack(),
 			"aws_config_delivery_channel":              configservice.ResourceDeliveryChannel(),
 			"aws_config_organization_conformance_pack": configservice.ResourceOrganizationConformancePack(),
 			"aws_config_organization_custom_rule":      configservice.ResourceOrganizationCustomRule(),
 			"aws_config_organization_managed_rule":     configservice.ResourceOrganizationManagedRule(),
 			"aws_config_remediation_configuration":     configservice.ResourceRemediationConfiguration(),
+			"aws_config_tag":                           configservice.ResourceTag(), // lintignore:AWSR006
 
 			"aws_connect_contact_flow": connect.ResourceContactFlow(),
 			"aws_connect_instance":     connect.ResourceInstance(),
@@ -954,18 +1136,21 @@ func Provider() *schema.Provider {
 			"aws_datasync_location_nfs":                     datasync.ResourceLocationNFS(),
 			"aws_datasync_location_s3":                      datasync.ResourceLocationS3(),
 			"aws_datasync_location_smb":                     datasync.ResourceLocationSMB(),
+			"aws_datasync_tag":                              datasync.ResourceTag(), // lintignore:AWSR006
 			"aws_datasync_task":                             datasync.ResourceTask(),
 
 			"aws_dax_cluster":         dax.ResourceCluster(),
 			"aws_dax_parameter_group": dax.ResourceParameterGroup(),
 			"aws_dax_subnet_group":    dax.ResourceSubnetGroup(),
+			"aws_dax_tag":             dax.ResourceTag(), // lintignore:AWSR006
 
 			"aws_devicefarm_project": devicefarm.ResourceProject(),
+			"aws_devicefarm_tag":     devicefarm.ResourceTag(), // lintignore:AWSR006
 
 			"aws_dx_bgp_peer":                                  directconnect.ResourceBGPPeer(),
 			"aws_dx_connection":                                directconnect.ResourceConnection(),
 			"aws_dx_connection_association":                    directconnect.ResourceConnectionAssociation(),
-			"aws_dx_connection_confirmation":                   directconnect.ResourceConnectionConfirmation(),
+			"aws_dx_connection_confirmation":                   directconnect.ResourceConnectionConfirmation(), // lintignore:AWSR006
 			"aws_dx_gateway":                                   directconnect.ResourceGateway(),
 			"aws_dx_gateway_association":                       directconnect.ResourceGatewayAssociation(),
 			"aws_dx_gateway_association_proposal":              directconnect.ResourceGatewayAssociationProposal(),
@@ -979,9 +1164,11 @@ func Provider() *schema.Provider {
 			"aws_dx_lag":                       directconnect.ResourceLag(),
 			"aws_dx_private_virtual_interface": directconnect.ResourcePrivateVirtualInterface(),
 			"aws_dx_public_virtual_interface":  directconnect.ResourcePublicVirtualInterface(),
+			"aws_dx_tag":                       directconnect.ResourceTag(), // lintignore:AWSR006
 			"aws_dx_transit_virtual_interface": directconnect.ResourceTransitVirtualInterface(),
 
 			"aws_dlm_lifecycle_policy": dlm.ResourceLifecyclePolicy(),
+			"aws_dlm_tag":              dlm.ResourceTag(), // lintignore:AWSR006
 
 			"aws_dms_certificate":              dms.ResourceCertificate(),
 			"aws_dms_endpoint":                 dms.ResourceEndpoint(),
@@ -989,6 +1176,7 @@ func Provider() *schema.Provider {
 			"aws_dms_replication_instance":     dms.ResourceReplicationInstance(),
 			"aws_dms_replication_subnet_group": dms.ResourceReplicationSubnetGroup(),
 			"aws_dms_replication_task":         dms.ResourceReplicationTask(),
+			"aws_dms_tag":                      dms.ResourceTag(), // lintignore:AWSR006
 
 			"aws_docdb_cluster":                 docdb.ResourceCluster(),
 			"aws_docdb_cluster_instance":        docdb.ResourceClusterInstance(),
@@ -996,48 +1184,50 @@ func Provider() *schema.Provider {
 			"aws_docdb_cluster_snapshot":        docdb.ResourceClusterSnapshot(),
 			"aws_docdb_global_cluster":          docdb.ResourceGlobalCluster(),
 			"aws_docdb_subnet_group":            docdb.ResourceSubnetGroup(),
+			"aws_docdb_tag":                     docdb.ResourceTag(), // lintignore:AWSR006
 
 			"aws_directory_service_conditional_forwarder": ds.ResourceConditionalForwarder(),
 			"aws_directory_service_directory":             ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":      ds.ResourceLogSubscription(),
+			"aws_directory_service_tag":                   ds.ResourceTag(), // lintignore:AWSR006
 
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
-			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
+			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(), // lintignore:AWSR006
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
-			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
-			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
+			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(), // lintignore:AWSR006
+			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),       // lintignore:AWSR006
 
 			"aws_ami":                                             ec2.ResourceAMI(),
 			"aws_ami_copy":                                        ec2.ResourceAMICopy(),
 			"aws_ami_from_instance":                               ec2.ResourceAMIFromInstance(),
 			"aws_ami_launch_permission":                           ec2.ResourceAMILaunchPermission(),
 			"aws_customer_gateway":                                ec2.ResourceCustomerGateway(),
-			"aws_default_network_acl":                             ec2.ResourceDefaultNetworkACL(),
-			"aws_default_route_table":                             ec2.ResourceDefaultRouteTable(),
-			"aws_default_security_group":                          ec2.ResourceDefaultSecurityGroup(),
-			"aws_default_subnet":                                  ec2.ResourceDefaultSubnet(),
-			"aws_default_vpc":                                     ec2.ResourceDefaultVPC(),
-			"aws_default_vpc_dhcp_options":                        ec2.ResourceDefaultVPCDHCPOptions(),
-			"aws_ebs_default_kms_key":                             ec2.ResourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                       ec2.ResourceEBSEncryptionByDefault(),
+			"aws_default_network_acl":                             ec2.ResourceDefaultNetworkACL(),      // lintignore:AWSR006
+			"aws_default_route_table":                             ec2.ResourceDefaultRouteTable(),      // lintignore:AWSR006
+			"aws_default_security_group":                          ec2.ResourceDefaultSecurityGroup(),   // lintignore:AWSR006
+			"aws_default_subnet":                                  ec2.ResourceDefaultSubnet(),          // lintignore:AWSR006
+			"aws_default_vpc":                                     ec2.ResourceDefaultVPC(),             // lintignore:AWSR006
+			"aws_default_vpc_dhcp_options":                        ec2.ResourceDefaultVPCDHCPOptions(),  // lintignore:AWSR006
+			"aws_ebs_default_kms_key":                             ec2.ResourceEBSDefaultKMSKey(),       // lintignore:AWSR006
+			"aws_ebs_encryption_by_default":                       ec2.ResourceEBSEncryptionByDefault(), // lintignore:AWSR006
 			"aws_ebs_snapshot":                                    ec2.ResourceEBSSnapshot(),
 			"aws_ebs_snapshot_copy":                               ec2.ResourceEBSSnapshotCopy(),
 			"aws_ebs_snapshot_import":                             ec2.ResourceEBSSnapshotImport(),
 			"aws_ebs_volume":                                      ec2.ResourceEBSVolume(),
-			"aws_ec2_availability_zone_group":                     ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_availability_zone_group":                     ec2.ResourceAvailabilityZoneGroup(), // lintignore:AWSR006
 			"aws_ec2_capacity_reservation":                        ec2.ResourceCapacityReservation(),
 			"aws_ec2_carrier_gateway":                             ec2.ResourceCarrierGateway(),
-			"aws_ec2_client_vpn_authorization_rule":               ec2.ResourceClientVPNAuthorizationRule(),
+			"aws_ec2_client_vpn_authorization_rule":               ec2.ResourceClientVPNAuthorizationRule(), // lintignore:AWSR006
 			"aws_ec2_client_vpn_endpoint":                         ec2.ResourceClientVPNEndpoint(),
 			"aws_ec2_client_vpn_network_association":              ec2.ResourceClientVPNNetworkAssociation(),
-			"aws_ec2_client_vpn_route":                            ec2.ResourceClientVPNRoute(),
+			"aws_ec2_client_vpn_route":                            ec2.ResourceClientVPNRoute(), // lintignore:AWSR006
 			"aws_ec2_fleet":                                       ec2.ResourceFleet(),
 			"aws_ec2_host":                                        ec2.ResourceHost(),
 			"aws_ec2_local_gateway_route":                         ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
 			"aws_ec2_managed_prefix_list_entry":                   ec2.ResourceManagedPrefixListEntry(),
-			"aws_ec2_tag":                                         ec2.ResourceTag(),
+			"aws_ec2_tag":                                         ec2.ResourceTag(), // lintignore:AWSR006
 			"aws_ec2_traffic_mirror_filter":                       ec2.ResourceTrafficMirrorFilter(),
 			"aws_ec2_traffic_mirror_filter_rule":                  ec2.ResourceTrafficMirrorFilterRule(),
 			"aws_ec2_traffic_mirror_session":                      ec2.ResourceTrafficMirrorSession(),
@@ -1060,69 +1250,72 @@ func Provider() *schema.Provider {
 			"aws_internet_gateway":                                ec2.ResourceInternetGateway(),
 			"aws_key_pair":                                        ec2.ResourceKeyPair(),
 			"aws_launch_template":                                 ec2.ResourceLaunchTemplate(),
-			"aws_main_route_table_association":                    ec2.ResourceMainRouteTableAssociation(),
+			"aws_main_route_table_association":                    ec2.ResourceMainRouteTableAssociation(), // lintignore:AWSR006
 			"aws_nat_gateway":                                     ec2.ResourceNatGateway(),
 			"aws_network_acl":                                     ec2.ResourceNetworkACL(),
-			"aws_network_acl_rule":                                ec2.ResourceNetworkACLRule(),
+			"aws_network_acl_rule":                                ec2.ResourceNetworkACLRule(), // lintignore:AWSR006
 			"aws_network_interface":                               ec2.ResourceNetworkInterface(),
 			"aws_network_interface_attachment":                    ec2.ResourceNetworkInterfaceAttachment(),
 			"aws_network_interface_sg_attachment":                 ec2.ResourceNetworkInterfaceSGAttachment(),
 			"aws_placement_group":                                 ec2.ResourcePlacementGroup(),
-			"aws_route":                                           ec2.ResourceRoute(),
+			"aws_route":                                           ec2.ResourceRoute(), // lintignore:AWSR006
 			"aws_route_table":                                     ec2.ResourceRouteTable(),
-			"aws_route_table_association":                         ec2.ResourceRouteTableAssociation(),
+			"aws_route_table_association":                         ec2.ResourceRouteTableAssociation(), // lintignore:AWSR006
 			"aws_security_group":                                  ec2.ResourceSecurityGroup(),
-			"aws_security_group_rule":                             ec2.ResourceSecurityGroupRule(),
+			"aws_security_group_rule":                             ec2.ResourceSecurityGroupRule(), // lintignore:AWSR006
 			"aws_snapshot_create_volume_permission":               ec2.ResourceSnapshotCreateVolumePermission(),
-			"aws_spot_datafeed_subscription":                      ec2.ResourceSpotDataFeedSubscription(),
+			"aws_spot_datafeed_subscription":                      ec2.ResourceSpotDataFeedSubscription(), // lintignore:AWSR006
 			"aws_spot_fleet_request":                              ec2.ResourceSpotFleetRequest(),
 			"aws_spot_instance_request":                           ec2.ResourceSpotInstanceRequest(),
 			"aws_subnet":                                          ec2.ResourceSubnet(),
 			"aws_volume_attachment":                               ec2.ResourceVolumeAttachment(),
 			"aws_vpc":                                             ec2.ResourceVPC(),
 			"aws_vpc_dhcp_options":                                ec2.ResourceVPCDHCPOptions(),
-			"aws_vpc_dhcp_options_association":                    ec2.ResourceVPCDHCPOptionsAssociation(),
+			"aws_vpc_dhcp_options_association":                    ec2.ResourceVPCDHCPOptionsAssociation(), // lintignore:AWSR006
 			"aws_vpc_endpoint":                                    ec2.ResourceVPCEndpoint(),
-			"aws_vpc_endpoint_connection_notification":            ec2.ResourceVPCEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":            ec2.ResourceVPCEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_connection_notification":            ec2.ResourceVPCEndpointConnectionNotification(), // lintignore:AWSR006
+			"aws_vpc_endpoint_route_table_association":            ec2.ResourceVPCEndpointRouteTableAssociation(),  // lintignore:AWSR006
 			"aws_vpc_endpoint_service":                            ec2.ResourceVPCEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":          ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
-			"aws_vpc_endpoint_subnet_association":                 ec2.ResourceVPCEndpointSubnetAssociation(),
-			"aws_vpc_ipv4_cidr_block_association":                 ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpc_endpoint_service_allowed_principal":          ec2.ResourceVPCEndpointServiceAllowedPrincipal(), // lintignore:AWSR006
+			"aws_vpc_endpoint_subnet_association":                 ec2.ResourceVPCEndpointSubnetAssociation(),       // lintignore:AWSR006
+			"aws_vpc_ipv4_cidr_block_association":                 ec2.ResourceVPCIPv4CIDRBlockAssociation(),        // lintignore:AWSR006
 			"aws_vpc_peering_connection":                          ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                 ec2.ResourceVPCPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(), // lintignore:AWSR006
 			"aws_vpn_connection":                                  ec2.ResourceVPNConnection(),
-			"aws_vpn_connection_route":                            ec2.ResourceVPNConnectionRoute(),
+			"aws_vpn_connection_route":                            ec2.ResourceVPNConnectionRoute(), // lintignore:AWSR006
 			"aws_vpn_gateway":                                     ec2.ResourceVPNGateway(),
 			"aws_vpn_gateway_attachment":                          ec2.ResourceVPNGatewayAttachment(),
 			"aws_vpn_gateway_route_propagation":                   ec2.ResourceVPNGatewayRoutePropagation(),
 
-			"aws_ecr_lifecycle_policy":          ecr.ResourceLifecyclePolicy(),
-			"aws_ecr_registry_policy":           ecr.ResourceRegistryPolicy(),
-			"aws_ecr_replication_configuration": ecr.ResourceReplicationConfiguration(),
+			"aws_ecr_lifecycle_policy":          ecr.ResourceLifecyclePolicy(),          // lintignore:AWSR006
+			"aws_ecr_registry_policy":           ecr.ResourceRegistryPolicy(),           // lintignore:AWSR006
+			"aws_ecr_replication_configuration": ecr.ResourceReplicationConfiguration(), // lintignore:AWSR006
 			"aws_ecr_repository":                ecr.ResourceRepository(),
-			"aws_ecr_repository_policy":         ecr.ResourceRepositoryPolicy(),
+			"aws_ecr_repository_policy":         ecr.ResourceRepositoryPolicy(), // lintignore:AWSR006
+			"aws_ecr_tag":                       ecr.ResourceTag(),              // lintignore:AWSR006
 
 			"aws_ecrpublic_repository": ecrpublic.ResourceRepository(),
 
 			"aws_ecs_capacity_provider": ecs.ResourceCapacityProvider(),
 			"aws_ecs_cluster":           ecs.ResourceCluster(),
 			"aws_ecs_service":           ecs.ResourceService(),
-			"aws_ecs_tag":               ecs.ResourceTag(),
+			"aws_ecs_tag":               ecs.ResourceTag(), // lintignore:AWSR006
 			"aws_ecs_task_definition":   ecs.ResourceTaskDefinition(),
 
 			"aws_efs_access_point":       efs.ResourceAccessPoint(),
-			"aws_efs_backup_policy":      efs.ResourceBackupPolicy(),
+			"aws_efs_backup_policy":      efs.ResourceBackupPolicy(), // lintignore:AWSR006
 			"aws_efs_file_system":        efs.ResourceFileSystem(),
-			"aws_efs_file_system_policy": efs.ResourceFileSystemPolicy(),
+			"aws_efs_file_system_policy": efs.ResourceFileSystemPolicy(), // lintignore:AWSR006
 			"aws_efs_mount_target":       efs.ResourceMountTarget(),
+			"aws_efs_tag":                efs.ResourceTag(), // lintignore:AWSR006
 
 			"aws_eks_addon":                    eks.ResourceAddon(),
 			"aws_eks_cluster":                  eks.ResourceCluster(),
 			"aws_eks_fargate_profile":          eks.ResourceFargateProfile(),
 			"aws_eks_identity_provider_config": eks.ResourceIdentityProviderConfig(),
 			"aws_eks_node_group":               eks.ResourceNodeGroup(),
+			"aws_eks_tag":                      eks.ResourceTag(), // lintignore:AWSR006
 
 			"aws_elasticache_cluster":                  elasticache.ResourceCluster(),
 			"aws_elasticache_global_replication_group": elasticache.ResourceGlobalReplicationGroup(),
@@ -1130,6 +1323,7 @@ func Provider() *schema.Provider {
 			"aws_elasticache_replication_group":        elasticache.ResourceReplicationGroup(),
 			"aws_elasticache_security_group":           elasticache.ResourceSecurityGroup(),
 			"aws_elasticache_subnet_group":             elasticache.ResourceSubnetGroup(),
+			"aws_elasticache_tag":                      elasticache.ResourceTag(), // lintignore:AWSR006
 			"aws_elasticache_user":                     elasticache.ResourceUser(),
 			"aws_elasticache_user_group":               elasticache.ResourceUserGroup(),
 
@@ -1137,44 +1331,49 @@ func Provider() *schema.Provider {
 			"aws_elastic_beanstalk_application_version":    elasticbeanstalk.ResourceApplicationVersion(),
 			"aws_elastic_beanstalk_configuration_template": elasticbeanstalk.ResourceConfigurationTemplate(),
 			"aws_elastic_beanstalk_environment":            elasticbeanstalk.ResourceEnvironment(),
+			"aws_elastic_beanstalk_tag":                    elasticbeanstalk.ResourceTag(), // lintignore:AWSR006
 
 			"aws_elasticsearch_domain":              elasticsearch.ResourceDomain(),
-			"aws_elasticsearch_domain_policy":       elasticsearch.ResourceDomainPolicy(),
-			"aws_elasticsearch_domain_saml_options": elasticsearch.ResourceDomainSAMLOptions(),
+			"aws_elasticsearch_domain_policy":       elasticsearch.ResourceDomainPolicy(),      // lintignore:AWSR006
+			"aws_elasticsearch_domain_saml_options": elasticsearch.ResourceDomainSAMLOptions(), // lintignore:AWSR006
+			"aws_elasticsearch_tag":                 elasticsearch.ResourceTag(),               // lintignore:AWSR006
 
 			"aws_elastictranscoder_pipeline": elastictranscoder.ResourcePipeline(),
 			"aws_elastictranscoder_preset":   elastictranscoder.ResourcePreset(),
 
-			"aws_app_cookie_stickiness_policy":        elb.ResourceAppCookieStickinessPolicy(),
+			"aws_app_cookie_stickiness_policy":        elb.ResourceAppCookieStickinessPolicy(), // lintignore:AWSR006
 			"aws_elb":                                 elb.ResourceLoadBalancer(),
-			"aws_elb_attachment":                      elb.ResourceAttachment(),
-			"aws_lb_cookie_stickiness_policy":         elb.ResourceCookieStickinessPolicy(),
-			"aws_lb_ssl_negotiation_policy":           elb.ResourceSSLNegotiationPolicy(),
-			"aws_load_balancer_backend_server_policy": elb.ResourceBackendServerPolicy(),
-			"aws_load_balancer_listener_policy":       elb.ResourceListenerPolicy(),
-			"aws_load_balancer_policy":                elb.ResourcePolicy(),
-			"aws_proxy_protocol_policy":               elb.ResourceProxyProtocolPolicy(),
+			"aws_elb_attachment":                      elb.ResourceAttachment(),             // lintignore:AWSR006
+			"aws_elb_tag":                             elb.ResourceTag(),                    // lintignore:AWSR006
+			"aws_lb_cookie_stickiness_policy":         elb.ResourceCookieStickinessPolicy(), // lintignore:AWSR006
+			"aws_lb_ssl_negotiation_policy":           elb.ResourceSSLNegotiationPolicy(),   // lintignore:AWSR006
+			"aws_load_balancer_backend_server_policy": elb.ResourceBackendServerPolicy(),    // lintignore:AWSR006
+			"aws_load_balancer_listener_policy":       elb.ResourceListenerPolicy(),         // lintignore:AWSR006
+			"aws_load_balancer_policy":                elb.ResourcePolicy(),                 // lintignore:AWSR006
+			"aws_proxy_protocol_policy":               elb.ResourceProxyProtocolPolicy(),    // lintignore:AWSR006
 
 			"aws_alb":                         elbv2.ResourceLoadBalancer(),
-			"aws_alb_listener":                elbv2.ResourceListener(),
-			"aws_alb_listener_certificate":    elbv2.ResourceListenerCertificate(),
-			"aws_alb_listener_rule":           elbv2.ResourceListenerRule(),
+			"aws_alb_listener":                elbv2.ResourceListener(),            // lintignore:AWSR006
+			"aws_alb_listener_certificate":    elbv2.ResourceListenerCertificate(), // lintignore:AWSR006
+			"aws_alb_listener_rule":           elbv2.ResourceListenerRule(),        // lintignore:AWSR006
 			"aws_alb_target_group":            elbv2.ResourceTargetGroup(),
 			"aws_alb_target_group_attachment": elbv2.ResourceTargetGroupAttachment(),
 			"aws_lb":                          elbv2.ResourceLoadBalancer(),
-			"aws_lb_listener":                 elbv2.ResourceListener(),
-			"aws_lb_listener_certificate":     elbv2.ResourceListenerCertificate(),
-			"aws_lb_listener_rule":            elbv2.ResourceListenerRule(),
+			"aws_lb_listener":                 elbv2.ResourceListener(),            // lintignore:AWSR006
+			"aws_lb_listener_certificate":     elbv2.ResourceListenerCertificate(), // lintignore:AWSR006
+			"aws_lb_listener_rule":            elbv2.ResourceListenerRule(),        // lintignore:AWSR006
+			"aws_lb_tag":                      elbv2.ResourceTag(),                 // lintignore:AWSR006
 			"aws_lb_target_group":             elbv2.ResourceTargetGroup(),
 			"aws_lb_target_group_attachment":  elbv2.ResourceTargetGroupAttachment(),
 
 			"aws_emr_cluster":                emr.ResourceCluster(),
-			"aws_emr_instance_fleet":         emr.ResourceInstanceFleet(),
-			"aws_emr_instance_group":         emr.ResourceInstanceGroup(),
-			"aws_emr_managed_scaling_policy": emr.ResourceManagedScalingPolicy(),
+			"aws_emr_instance_fleet":         emr.ResourceInstanceFleet(),        // lintignore:AWSR006
+			"aws_emr_instance_group":         emr.ResourceInstanceGroup(),        // lintignore:AWSR006
+			"aws_emr_managed_scaling_policy": emr.ResourceManagedScalingPolicy(), // lintignore:AWSR006
 			"aws_emr_security_configuration": emr.ResourceSecurityConfiguration(),
 
 			"aws_kinesis_firehose_delivery_stream": firehose.ResourceDeliveryStream(),
+			"aws_kinesis_firehose_tag":             firehose.ResourceTag(), // lintignore:AWSR006
 
 			"aws_fms_admin_account": fms.ResourceAdminAccount(),
 			"aws_fms_policy":        fms.ResourcePolicy(),
@@ -1182,26 +1381,30 @@ func Provider() *schema.Provider {
 			"aws_fsx_backup":              fsx.ResourceBackup(),
 			"aws_fsx_lustre_file_system":  fsx.ResourceLustreFileSystem(),
 			"aws_fsx_ontap_file_system":   fsx.ResourceOntapFileSystem(),
+			"aws_fsx_tag":                 fsx.ResourceTag(), // lintignore:AWSR006
 			"aws_fsx_windows_file_system": fsx.ResourceWindowsFileSystem(),
 
 			"aws_gamelift_alias":              gamelift.ResourceAlias(),
 			"aws_gamelift_build":              gamelift.ResourceBuild(),
 			"aws_gamelift_fleet":              gamelift.ResourceFleet(),
 			"aws_gamelift_game_session_queue": gamelift.ResourceGameSessionQueue(),
+			"aws_gamelift_tag":                gamelift.ResourceTag(), // lintignore:AWSR006
 
+			"aws_glacier_tag":        glacier.ResourceTag(), // lintignore:AWSR006
 			"aws_glacier_vault":      glacier.ResourceVault(),
-			"aws_glacier_vault_lock": glacier.ResourceVaultLock(),
+			"aws_glacier_vault_lock": glacier.ResourceVaultLock(), // lintignore:AWSR006
 
 			"aws_globalaccelerator_accelerator":    globalaccelerator.ResourceAccelerator(),
-			"aws_globalaccelerator_endpoint_group": globalaccelerator.ResourceEndpointGroup(),
-			"aws_globalaccelerator_listener":       globalaccelerator.ResourceListener(),
+			"aws_globalaccelerator_endpoint_group": globalaccelerator.ResourceEndpointGroup(), // lintignore:AWSR006
+			"aws_globalaccelerator_listener":       globalaccelerator.ResourceListener(),      // lintignore:AWSR006
+			"aws_globalaccelerator_tag":            globalaccelerator.ResourceTag(),           // lintignore:AWSR006
 
 			"aws_glue_catalog_database":                 glue.ResourceCatalogDatabase(),
-			"aws_glue_catalog_table":                    glue.ResourceCatalogTable(),
+			"aws_glue_catalog_table":                    glue.ResourceCatalogTable(), // lintignore:AWSR006
 			"aws_glue_classifier":                       glue.ResourceClassifier(),
 			"aws_glue_connection":                       glue.ResourceConnection(),
 			"aws_glue_crawler":                          glue.ResourceCrawler(),
-			"aws_glue_data_catalog_encryption_settings": glue.ResourceDataCatalogEncryptionSettings(),
+			"aws_glue_data_catalog_encryption_settings": glue.ResourceDataCatalogEncryptionSettings(), // lintignore:AWSR006
 			"aws_glue_dev_endpoint":                     glue.ResourceDevEndpoint(),
 			"aws_glue_job":                              glue.ResourceJob(),
 			"aws_glue_ml_transform":                     glue.ResourceMLTransform(),
@@ -1211,43 +1414,47 @@ func Provider() *schema.Provider {
 			"aws_glue_resource_policy":                  glue.ResourceResourcePolicy(),
 			"aws_glue_schema":                           glue.ResourceSchema(),
 			"aws_glue_security_configuration":           glue.ResourceSecurityConfiguration(),
+			"aws_glue_tag":                              glue.ResourceTag(), // lintignore:AWSR006
 			"aws_glue_trigger":                          glue.ResourceTrigger(),
-			"aws_glue_user_defined_function":            glue.ResourceUserDefinedFunction(),
+			"aws_glue_user_defined_function":            glue.ResourceUserDefinedFunction(), // lintignore:AWSR006
 			"aws_glue_workflow":                         glue.ResourceWorkflow(),
 
+			"aws_greengrass_tag": greengrass.ResourceTag(), // lintignore:AWSR006
+
 			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
-			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
+			"aws_guardduty_filter":                     guardduty.ResourceFilter(), // lintignore:AWSR006
 			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
-			"aws_guardduty_ipset":                      guardduty.ResourceIPSet(),
-			"aws_guardduty_member":                     guardduty.ResourceMember(),
+			"aws_guardduty_ipset":                      guardduty.ResourceIPSet(),  // lintignore:AWSR006
+			"aws_guardduty_member":                     guardduty.ResourceMember(), // lintignore:AWSR006
 			"aws_guardduty_organization_admin_account": guardduty.ResourceOrganizationAdminAccount(),
-			"aws_guardduty_organization_configuration": guardduty.ResourceOrganizationConfiguration(),
+			"aws_guardduty_organization_configuration": guardduty.ResourceOrganizationConfiguration(), // lintignore:AWSR006
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
-			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
+			"aws_guardduty_tag":                        guardduty.ResourceTag(),            // lintignore:AWSR006
+			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(), // lintignore:AWSR006
 
-			"aws_iam_access_key":              iam.ResourceAccessKey(),
-			"aws_iam_account_alias":           iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy": iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_access_key":              iam.ResourceAccessKey(),             // lintignore:AWSR006
+			"aws_iam_account_alias":           iam.ResourceAccountAlias(),          // lintignore:AWSR006
+			"aws_iam_account_password_policy": iam.ResourceAccountPasswordPolicy(), // lintignore:AWSR006
 			"aws_iam_group":                   iam.ResourceGroup(),
-			"aws_iam_group_membership":        iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":            iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment": iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_group_membership":        iam.ResourceGroupMembership(),       // lintignore:AWSR006
+			"aws_iam_group_policy":            iam.ResourceGroupPolicy(),           // lintignore:AWSR006
+			"aws_iam_group_policy_attachment": iam.ResourceGroupPolicyAttachment(), // lintignore:AWSR006
 			"aws_iam_instance_profile":        iam.ResourceInstanceProfile(),
 			"aws_iam_openid_connect_provider": iam.ResourceOpenIDConnectProvider(),
 			"aws_iam_policy":                  iam.ResourcePolicy(),
 			"aws_iam_policy_attachment":       iam.ResourcePolicyAttachment(),
 			"aws_iam_role":                    iam.ResourceRole(),
-			"aws_iam_role_policy":             iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":  iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy":             iam.ResourceRolePolicy(),           // lintignore:AWSR006
+			"aws_iam_role_policy_attachment":  iam.ResourceRolePolicyAttachment(), // lintignore:AWSR006
 			"aws_iam_saml_provider":           iam.ResourceSamlProvider(),
 			"aws_iam_server_certificate":      iam.ResourceServerCertificate(),
 			"aws_iam_service_linked_role":     iam.ResourceServiceLinkedRole(),
 			"aws_iam_user":                    iam.ResourceUser(),
-			"aws_iam_user_group_membership":   iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":      iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":             iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":  iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":            iam.ResourceUserSSHKey(),
+			"aws_iam_user_group_membership":   iam.ResourceUserGroupMembership(),  // lintignore:AWSR006
+			"aws_iam_user_login_profile":      iam.ResourceUserLoginProfile(),     // lintignore:AWSR006
+			"aws_iam_user_policy":             iam.ResourceUserPolicy(),           // lintignore:AWSR006
+			"aws_iam_user_policy_attachment":  iam.ResourceUserPolicyAttachment(), // lintignore:AWSR006
+			"aws_iam_user_ssh_key":            iam.ResourceUserSSHKey(),           // lintignore:AWSR006
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_distribution_configuration":   imagebuilder.ResourceDistributionConfiguration(),
@@ -1255,6 +1462,7 @@ func Provider() *schema.Provider {
 			"aws_imagebuilder_image_pipeline":               imagebuilder.ResourceImagePipeline(),
 			"aws_imagebuilder_image_recipe":                 imagebuilder.ResourceImageRecipe(),
 			"aws_imagebuilder_infrastructure_configuration": imagebuilder.ResourceInfrastructureConfiguration(),
+			"aws_imagebuilder_tag":                          imagebuilder.ResourceTag(), // lintignore:AWSR006
 
 			"aws_inspector_assessment_target":   inspector.ResourceAssessmentTarget(),
 			"aws_inspector_assessment_template": inspector.ResourceAssessmentTemplate(),
@@ -1265,44 +1473,56 @@ func Provider() *schema.Provider {
 			"aws_iot_policy":                     iot.ResourcePolicy(),
 			"aws_iot_policy_attachment":          iot.ResourcePolicyAttachment(),
 			"aws_iot_role_alias":                 iot.ResourceRoleAlias(),
+			"aws_iot_tag":                        iot.ResourceTag(), // lintignore:AWSR006
 			"aws_iot_thing":                      iot.ResourceThing(),
 			"aws_iot_thing_principal_attachment": iot.ResourceThingPrincipalAttachment(),
 			"aws_iot_thing_type":                 iot.ResourceThingType(),
 			"aws_iot_topic_rule":                 iot.ResourceTopicRule(),
 
+			"aws_iotanalytics_tag": iotanalytics.ResourceTag(), // lintignore:AWSR006
+
+			"aws_iotevents_tag": iotevents.ResourceTag(), // lintignore:AWSR006
+
 			"aws_msk_cluster":                  kafka.ResourceCluster(),
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
+			"aws_msk_tag":                      kafka.ResourceTag(), // lintignore:AWSR006
 
 			"aws_kinesis_stream":          kinesis.ResourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.ResourceStreamConsumer(),
+			"aws_kinesis_tag":             kinesis.ResourceTag(), // lintignore:AWSR006
 
 			"aws_kinesis_analytics_application":           kinesisanalytics.ResourceApplication(),
+			"aws_kinesis_analytics_tag":                   kinesisanalytics.ResourceTag(), // lintignore:AWSR006
 			"aws_kinesisanalyticsv2_application":          kinesisanalyticsv2.ResourceApplication(),
 			"aws_kinesisanalyticsv2_application_snapshot": kinesisanalyticsv2.ResourceApplicationSnapshot(),
+			"aws_kinesisanalyticsv2_tag":                  kinesisanalyticsv2.ResourceTag(), // lintignore:AWSR006
 
 			"aws_kinesis_video_stream": kinesisvideo.ResourceStream(),
+			"aws_kinesis_video_tag":    kinesisvideo.ResourceTag(), // lintignore:AWSR006
 
 			"aws_kms_alias":                kms.ResourceAlias(),
-			"aws_kms_ciphertext":           kms.ResourceCiphertext(),
+			"aws_kms_ciphertext":           kms.ResourceCiphertext(), // lintignore:AWSR006
 			"aws_kms_external_key":         kms.ResourceExternalKey(),
 			"aws_kms_grant":                kms.ResourceGrant(),
 			"aws_kms_key":                  kms.ResourceKey(),
 			"aws_kms_replica_external_key": kms.ResourceReplicaExternalKey(),
 			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
+			"aws_kms_tag":                  kms.ResourceTag(), // lintignore:AWSR006
 
-			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(),
+			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(), // lintignore:AWSR006
 			"aws_lakeformation_permissions":        lakeformation.ResourcePermissions(),
 			"aws_lakeformation_resource":           lakeformation.ResourceResource(),
 
-			"aws_lambda_alias":                          lambda.ResourceAlias(),
+			"aws_lambda_alias":                          lambda.ResourceAlias(), // lintignore:AWSR006
 			"aws_lambda_code_signing_config":            lambda.ResourceCodeSigningConfig(),
 			"aws_lambda_event_source_mapping":           lambda.ResourceEventSourceMapping(),
 			"aws_lambda_function":                       lambda.ResourceFunction(),
-			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(),
+			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(), // lintignore:AWSR006
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
-			"aws_lambda_permission":                     lambda.ResourcePermission(),
-			"aws_lambda_provisioned_concurrency_config": lambda.ResourceProvisionedConcurrencyConfig(),
+			"aws_lambda_permission":                     lambda.ResourcePermission(),                   // lintignore:AWSR006
+			"aws_lambda_provisioned_concurrency_config": lambda.ResourceProvisionedConcurrencyConfig(), // lintignore:AWSR006
+			"aws_lambda_tag":                            lambda.ResourceTag(),                          // lintignore:AWSR006
 
 			"aws_lex_bot":       lexmodels.ResourceBot(),
 			"aws_lex_bot_alias": lexmodels.ResourceBotAlias(),
@@ -1311,6 +1531,7 @@ func Provider() *schema.Provider {
 
 			"aws_licensemanager_association":           licensemanager.ResourceAssociation(),
 			"aws_licensemanager_license_configuration": licensemanager.ResourceLicenseConfiguration(),
+			"aws_licensemanager_tag":                   licensemanager.ResourceTag(), // lintignore:AWSR006
 
 			"aws_lightsail_domain":                lightsail.ResourceDomain(),
 			"aws_lightsail_instance":              lightsail.ResourceInstance(),
@@ -1322,7 +1543,7 @@ func Provider() *schema.Provider {
 			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
 
-			"aws_macie2_account":                    macie2.ResourceAccount(),
+			"aws_macie2_account":                    macie2.ResourceAccount(), // lintignore:AWSR006
 			"aws_macie2_classification_job":         macie2.ResourceClassificationJob(),
 			"aws_macie2_custom_data_identifier":     macie2.ResourceCustomDataIdentifier(),
 			"aws_macie2_findings_filter":            macie2.ResourceFindingsFilter(),
@@ -1330,15 +1551,23 @@ func Provider() *schema.Provider {
 			"aws_macie2_member":                     macie2.ResourceMember(),
 			"aws_macie2_organization_admin_account": macie2.ResourceOrganizationAdminAccount(),
 
+			"aws_mediaconnect_tag": mediaconnect.ResourceTag(), // lintignore:AWSR006
+
 			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
+			"aws_media_convert_tag":   mediaconvert.ResourceTag(), // lintignore:AWSR006
+
+			"aws_medialive_tag": medialive.ResourceTag(), // lintignore:AWSR006
 
 			"aws_media_package_channel": mediapackage.ResourceChannel(),
+			"aws_media_package_tag":     mediapackage.ResourceTag(), // lintignore:AWSR006
 
 			"aws_media_store_container":        mediastore.ResourceContainer(),
 			"aws_media_store_container_policy": mediastore.ResourceContainerPolicy(),
+			"aws_media_store_tag":              mediastore.ResourceTag(), // lintignore:AWSR006
 
 			"aws_mq_broker":        mq.ResourceBroker(),
 			"aws_mq_configuration": mq.ResourceConfiguration(),
+			"aws_mq_tag":           mq.ResourceTag(), // lintignore:AWSR006
 
 			"aws_mwaa_environment": mwaa.ResourceEnvironment(),
 
@@ -1350,12 +1579,16 @@ func Provider() *schema.Provider {
 			"aws_neptune_event_subscription":      neptune.ResourceEventSubscription(),
 			"aws_neptune_parameter_group":         neptune.ResourceParameterGroup(),
 			"aws_neptune_subnet_group":            neptune.ResourceSubnetGroup(),
+			"aws_neptune_tag":                     neptune.ResourceTag(), // lintignore:AWSR006
 
 			"aws_networkfirewall_firewall":              networkfirewall.ResourceFirewall(),
 			"aws_networkfirewall_firewall_policy":       networkfirewall.ResourceFirewallPolicy(),
 			"aws_networkfirewall_logging_configuration": networkfirewall.ResourceLoggingConfiguration(),
-			"aws_networkfirewall_resource_policy":       networkfirewall.ResourceResourcePolicy(),
+			"aws_networkfirewall_resource_policy":       networkfirewall.ResourceResourcePolicy(), // lintignore:AWSR006
 			"aws_networkfirewall_rule_group":            networkfirewall.ResourceRuleGroup(),
+			"aws_networkfirewall_tag":                   networkfirewall.ResourceTag(), // lintignore:AWSR006
+
+			"aws_networkmanager_tag": networkmanager.ResourceTag(), // lintignore:AWSR006
 
 			"aws_opsworks_application":      opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":     opsworks.ResourceCustomLayer(),
@@ -1372,32 +1605,37 @@ func Provider() *schema.Provider {
 			"aws_opsworks_rds_db_instance":  opsworks.ResourceRDSDBInstance(),
 			"aws_opsworks_stack":            opsworks.ResourceStack(),
 			"aws_opsworks_static_web_layer": opsworks.ResourceStaticWebLayer(),
+			"aws_opsworks_tag":              opsworks.ResourceTag(), // lintignore:AWSR006
 			"aws_opsworks_user_profile":     opsworks.ResourceUserProfile(),
 
 			"aws_organizations_account":                 organizations.ResourceAccount(),
 			"aws_organizations_delegated_administrator": organizations.ResourceDelegatedAdministrator(),
-			"aws_organizations_organization":            organizations.ResourceOrganization(),
+			"aws_organizations_organization":            organizations.ResourceOrganization(), // lintignore:AWSR006
 			"aws_organizations_organizational_unit":     organizations.ResourceOrganizationalUnit(),
 			"aws_organizations_policy":                  organizations.ResourcePolicy(),
 			"aws_organizations_policy_attachment":       organizations.ResourcePolicyAttachment(),
+			"aws_organizations_tag":                     organizations.ResourceTag(), // lintignore:AWSR006
 
-			"aws_pinpoint_adm_channel":               pinpoint.ResourceADMChannel(),
-			"aws_pinpoint_apns_channel":              pinpoint.ResourceAPNSChannel(),
-			"aws_pinpoint_apns_sandbox_channel":      pinpoint.ResourceAPNSSandboxChannel(),
-			"aws_pinpoint_apns_voip_channel":         pinpoint.ResourceAPNSVoIPChannel(),
-			"aws_pinpoint_apns_voip_sandbox_channel": pinpoint.ResourceAPNSVoIPSandboxChannel(),
+			"aws_pinpoint_adm_channel":               pinpoint.ResourceADMChannel(),             // lintignore:AWSR006
+			"aws_pinpoint_apns_channel":              pinpoint.ResourceAPNSChannel(),            // lintignore:AWSR006
+			"aws_pinpoint_apns_sandbox_channel":      pinpoint.ResourceAPNSSandboxChannel(),     // lintignore:AWSR006
+			"aws_pinpoint_apns_voip_channel":         pinpoint.ResourceAPNSVoIPChannel(),        // lintignore:AWSR006
+			"aws_pinpoint_apns_voip_sandbox_channel": pinpoint.ResourceAPNSVoIPSandboxChannel(), // lintignore:AWSR006
 			"aws_pinpoint_app":                       pinpoint.ResourceApp(),
-			"aws_pinpoint_baidu_channel":             pinpoint.ResourceBaiduChannel(),
-			"aws_pinpoint_email_channel":             pinpoint.ResourceEmailChannel(),
-			"aws_pinpoint_event_stream":              pinpoint.ResourceEventStream(),
-			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
-			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
+			"aws_pinpoint_baidu_channel":             pinpoint.ResourceBaiduChannel(), // lintignore:AWSR006
+			"aws_pinpoint_email_channel":             pinpoint.ResourceEmailChannel(), // lintignore:AWSR006
+			"aws_pinpoint_event_stream":              pinpoint.ResourceEventStream(),  // lintignore:AWSR006
+			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),   // lintignore:AWSR006
+			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),   // lintignore:AWSR006
+			"aws_pinpoint_tag":                       pinpoint.ResourceTag(),          // lintignore:AWSR006
 
 			"aws_qldb_ledger": qldb.ResourceLedger(),
+			"aws_qldb_tag":    qldb.ResourceTag(), // lintignore:AWSR006
 
 			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
 			"aws_quicksight_group":            quicksight.ResourceGroup(),
 			"aws_quicksight_group_membership": quicksight.ResourceGroupMembership(),
+			"aws_quicksight_tag":              quicksight.ResourceTag(), // lintignore:AWSR006
 			"aws_quicksight_user":             quicksight.ResourceUser(),
 
 			"aws_ram_principal_association":   ram.ResourcePrincipalAssociation(),
@@ -1408,22 +1646,23 @@ func Provider() *schema.Provider {
 			"aws_db_cluster_snapshot":           rds.ResourceClusterSnapshot(),
 			"aws_db_event_subscription":         rds.ResourceEventSubscription(),
 			"aws_db_instance":                   rds.ResourceInstance(),
-			"aws_db_instance_role_association":  rds.ResourceInstanceRoleAssociation(),
+			"aws_db_instance_role_association":  rds.ResourceInstanceRoleAssociation(), // lintignore:AWSR006
 			"aws_db_option_group":               rds.ResourceOptionGroup(),
 			"aws_db_parameter_group":            rds.ResourceParameterGroup(),
 			"aws_db_proxy":                      rds.ResourceProxy(),
-			"aws_db_proxy_default_target_group": rds.ResourceProxyDefaultTargetGroup(),
-			"aws_db_proxy_endpoint":             rds.ResourceProxyEndpoint(),
-			"aws_db_proxy_target":               rds.ResourceProxyTarget(),
+			"aws_db_proxy_default_target_group": rds.ResourceProxyDefaultTargetGroup(), // lintignore:AWSR006
+			"aws_db_proxy_endpoint":             rds.ResourceProxyEndpoint(),           // lintignore:AWSR006
+			"aws_db_proxy_target":               rds.ResourceProxyTarget(),             // lintignore:AWSR006
 			"aws_db_security_group":             rds.ResourceSecurityGroup(),
 			"aws_db_snapshot":                   rds.ResourceSnapshot(),
 			"aws_db_subnet_group":               rds.ResourceSubnetGroup(),
 			"aws_rds_cluster":                   rds.ResourceCluster(),
-			"aws_rds_cluster_endpoint":          rds.ResourceClusterEndpoint(),
-			"aws_rds_cluster_instance":          rds.ResourceClusterInstance(),
+			"aws_rds_cluster_endpoint":          rds.ResourceClusterEndpoint(), // lintignore:AWSR006
+			"aws_rds_cluster_instance":          rds.ResourceClusterInstance(), // lintignore:AWSR006
 			"aws_rds_cluster_parameter_group":   rds.ResourceClusterParameterGroup(),
-			"aws_rds_cluster_role_association":  rds.ResourceClusterRoleAssociation(),
+			"aws_rds_cluster_role_association":  rds.ResourceClusterRoleAssociation(), // lintignore:AWSR006
 			"aws_rds_global_cluster":            rds.ResourceGlobalCluster(),
+			"aws_rds_tag":                       rds.ResourceTag(), // lintignore:AWSR006
 
 			"aws_redshift_cluster":                       redshift.ResourceCluster(),
 			"aws_redshift_event_subscription":            redshift.ResourceEventSubscription(),
@@ -1432,20 +1671,21 @@ func Provider() *schema.Provider {
 			"aws_redshift_security_group":                redshift.ResourceSecurityGroup(),
 			"aws_redshift_snapshot_copy_grant":           redshift.ResourceSnapshotCopyGrant(),
 			"aws_redshift_snapshot_schedule":             redshift.ResourceSnapshotSchedule(),
-			"aws_redshift_snapshot_schedule_association": redshift.ResourceSnapshotScheduleAssociation(),
+			"aws_redshift_snapshot_schedule_association": redshift.ResourceSnapshotScheduleAssociation(), // lintignore:AWSR006
 			"aws_redshift_subnet_group":                  redshift.ResourceSubnetGroup(),
 
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
+			"aws_resourcegroups_tag":   resourcegroups.ResourceTag(), // lintignore:AWSR006
 
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
 			"aws_route53_health_check":                  route53.ResourceHealthCheck(),
-			"aws_route53_hosted_zone_dnssec":            route53.ResourceHostedZoneDNSSEC(),
+			"aws_route53_hosted_zone_dnssec":            route53.ResourceHostedZoneDNSSEC(), // lintignore:AWSR006
 			"aws_route53_key_signing_key":               route53.ResourceKeySigningKey(),
 			"aws_route53_query_log":                     route53.ResourceQueryLog(),
-			"aws_route53_record":                        route53.ResourceRecord(),
-			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(),
+			"aws_route53_record":                        route53.ResourceRecord(),                      // lintignore:AWSR006
+			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(), // lintignore:AWSR006
 			"aws_route53_zone":                          route53.ResourceZone(),
-			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
+			"aws_route53_zone_association":              route53.ResourceZoneAssociation(), // lintignore:AWSR006
 
 			"aws_route53recoverycontrolconfig_cluster":         route53recoverycontrolconfig.ResourceCluster(),
 			"aws_route53recoverycontrolconfig_control_panel":   route53recoverycontrolconfig.ResourceControlPanel(),
@@ -1456,6 +1696,7 @@ func Provider() *schema.Provider {
 			"aws_route53recoveryreadiness_readiness_check": route53recoveryreadiness.ResourceReadinessCheck(),
 			"aws_route53recoveryreadiness_recovery_group":  route53recoveryreadiness.ResourceRecoveryGroup(),
 			"aws_route53recoveryreadiness_resource_set":    route53recoveryreadiness.ResourceResourceSet(),
+			"aws_route53recoveryreadiness_tag":             route53recoveryreadiness.ResourceTag(), // lintignore:AWSR006
 
 			"aws_route53_resolver_dnssec_config":                   route53resolver.ResourceDNSSECConfig(),
 			"aws_route53_resolver_endpoint":                        route53resolver.ResourceEndpoint(),
@@ -1468,22 +1709,34 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_query_log_config_association":    route53resolver.ResourceQueryLogConfigAssociation(),
 			"aws_route53_resolver_rule":                            route53resolver.ResourceRule(),
 			"aws_route53_resolver_rule_association":                route53resolver.ResourceRuleAssociation(),
-
-			"aws_s3_bucket":                                   s3.ResourceBucket(),
-			"aws_s3_bucket_analytics_configuration":           s3.ResourceBucketAnalyticsConfiguration(),
-			"aws_s3_bucket_intelligent_tiering_configuration": s3.ResourceBucketIntelligentTieringConfiguration(),
-			"aws_s3_bucket_inventory":                         s3.ResourceBucketInventory(),
-			"aws_s3_bucket_metric":                            s3.ResourceBucketMetric(),
-			"aws_s3_bucket_notification":                      s3.ResourceBucketNotification(),
-			"aws_s3_bucket_object":                            s3.ResourceBucketObject(),
-			"aws_s3_bucket_ownership_controls":                s3.ResourceBucketOwnershipControls(),
-			"aws_s3_bucket_policy":                            s3.ResourceBucketPolicy(),
-			"aws_s3_bucket_public_access_block":               s3.ResourceBucketPublicAccessBlock(),
-			"aws_s3_object_copy":                              s3.ResourceObjectCopy(),
+			"aws_route53_resolver_tag":                             route53resolver.ResourceTag(), // lintignore:AWSR006
+
+			"aws_s3_bucket":                                      s3.ResourceBucket(),
+			"aws_s3_bucket_accelerate_configuration":             s3.ResourceBucketAccelerateConfiguration(),         // lintignore:AWSR006
+			"aws_s3_bucket_acl":                                  s3.ResourceBucketACL(),                             // lintignore:AWSR006
+			"aws_s3_bucket_analytics_configuration":              s3.ResourceBucketAnalyticsConfiguration(),          // lintignore:AWSR006
+			"aws_s3_bucket_cors_configuration":                   s3.ResourceBucketCORSConfiguration(),               // lintignore:AWSR006
+			"aws_s3_bucket_intelligent_tiering_configuration":    s3.ResourceBucketIntelligentTieringConfiguration(), // lintignore:AWSR006
+			"aws_s3_bucket_inventory":                            s3.ResourceBucketInventory(),                       // lintignore:AWSR006
+			"aws_s3_bucket_lifecycle_configuration":              s3.ResourceBucketLifecycleConfiguration(),          // lintignore:AWSR006
+			"aws_s3_bucket_logging":                              s3.ResourceBucketLogging(),                         // lintignore:AWSR006
+			"aws_s3_bucket_metric":                               s3.ResourceBucketMetric(),                          // lintignore:AWSR006
+			"aws_s3_bucket_notification":                         s3.ResourceBucketNotification(),                    // lintignore:AWSR006
+			"aws_s3_bucket_object":                               s3.ResourceBucketObject(),
+			"aws_s3_bucket_object_lock_configuration":            s3.ResourceBucketObjectLockConfiguration(),           // lintignore:AWSR006
+			"aws_s3_bucket_ownership_controls":                   s3.ResourceBucketOwnershipControls(),                 // lintignore:AWSR006
+			"aws_s3_bucket_policy":                               s3.ResourceBucketPolicy(),                            // lintignore:AWSR006
+			"aws_s3_bucket_public_access_block":                  s3.ResourceBucketPublicAccessBlock(),                 // lintignore:AWSR006
+			"aws_s3_bucket_replication_configuration":            s3.ResourceBucketReplicationConfiguration(),          // lintignore:AWSR006
+			"aws_s3_bucket_request_payment_configuration":        s3.ResourceBucketRequestPaymentConfiguration(),       // lintignore:AWSR006
+			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(), // lintignore:AWSR006
+			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),                        // lintignore:AWSR006
+			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),              // lintignore:AWSR006
+			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),                              // lintignore:AWSR006
 
 			"aws_s3_access_point":                             s3control.ResourceAccessPoint(),
 			"aws_s3control_access_point_policy":               s3control.ResourceAccessPointPolicy(),
-			"aws_s3_account_public_access_block":              s3control.ResourceAccountPublicAccessBlock(),
+			"aws_s3_account_public_access_block":              s3control.ResourceAccountPublicAccessBlock(), // lintignore:AWSR006
 			"aws_s3control_bucket":                            s3control.ResourceBucket(),
 			"aws_s3control_bucket_lifecycle_configuration":    s3control.ResourceBucketLifecycleConfiguration(),
 			"aws_s3control_bucket_policy":                     s3control.ResourceBucketPolicy(),
@@ -1508,10 +1761,11 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_image_version":                             sagemaker.ResourceImageVersion(),
 			"aws_sagemaker_model":                                     sagemaker.ResourceModel(),
 			"aws_sagemaker_model_package_group":                       sagemaker.ResourceModelPackageGroup(),
-			"aws_sagemaker_model_package_group_policy":                sagemaker.ResourceModelPackageGroupPolicy(),
+			"aws_sagemaker_model_package_group_policy":                sagemaker.ResourceModelPackageGroupPolicy(), // lintignore:AWSR006
 			"aws_sagemaker_notebook_instance":                         sagemaker.ResourceNotebookInstance(),
 			"aws_sagemaker_notebook_instance_lifecycle_configuration": sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
 			"aws_sagemaker_studio_lifecycle_config":                   sagemaker.ResourceStudioLifecycleConfig(),
+			"aws_sagemaker_tag":                                       sagemaker.ResourceTag(), // lintignore:AWSR006
 			"aws_sagemaker_user_profile":                              sagemaker.ResourceUserProfile(),
 			"aws_sagemaker_workforce":                                 sagemaker.ResourceWorkforce(),
 			"aws_sagemaker_workteam":                                  sagemaker.ResourceWorkteam(),
@@ -1519,23 +1773,25 @@ func Provider() *schema.Provider {
 			"aws_schemas_discoverer": schemas.ResourceDiscoverer(),
 			"aws_schemas_registry":   schemas.ResourceRegistry(),
 			"aws_schemas_schema":     schemas.ResourceSchema(),
+			"aws_schemas_tag":        schemas.ResourceTag(), // lintignore:AWSR006
 
 			"aws_secretsmanager_secret":          secretsmanager.ResourceSecret(),
 			"aws_secretsmanager_secret_policy":   secretsmanager.ResourceSecretPolicy(),
-			"aws_secretsmanager_secret_rotation": secretsmanager.ResourceSecretRotation(),
-			"aws_secretsmanager_secret_version":  secretsmanager.ResourceSecretVersion(),
+			"aws_secretsmanager_secret_rotation": secretsmanager.ResourceSecretRotation(), // lintignore:AWSR006
+			"aws_secretsmanager_secret_version":  secretsmanager.ResourceSecretVersion(),  // lintignore:AWSR006
 
-			"aws_securityhub_account":                    securityhub.ResourceAccount(),
+			"aws_securityhub_account":                    securityhub.ResourceAccount(), // lintignore:AWSR006
 			"aws_securityhub_action_target":              securityhub.ResourceActionTarget(),
 			"aws_securityhub_insight":                    securityhub.ResourceInsight(),
 			"aws_securityhub_invite_accepter":            securityhub.ResourceInviteAccepter(),
 			"aws_securityhub_member":                     securityhub.ResourceMember(),
 			"aws_securityhub_organization_admin_account": securityhub.ResourceOrganizationAdminAccount(),
-			"aws_securityhub_organization_configuration": securityhub.ResourceOrganizationConfiguration(),
+			"aws_securityhub_organization_configuration": securityhub.ResourceOrganizationConfiguration(), // lintignore:AWSR006
 			"aws_securityhub_product_subscription":       securityhub.ResourceProductSubscription(),
 			"aws_securityhub_standards_control":          securityhub.ResourceStandardsControl(),
 			"aws_securityhub_standards_subscription":     securityhub.ResourceStandardsSubscription(),
 			"aws_securityhub_finding_aggregator":         securityhub.ResourceFindingAggregator(),
+			"aws_securityhub_tag":                        securityhub.ResourceTag(), // lintignore:AWSR006
 
 			"aws_serverlessapplicationrepository_cloudformation_stack": serverlessrepo.ResourceCloudFormationStack(),
 
@@ -1558,51 +1814,57 @@ func Provider() *schema.Provider {
 			"aws_service_discovery_private_dns_namespace": servicediscovery.ResourcePrivateDNSNamespace(),
 			"aws_service_discovery_public_dns_namespace":  servicediscovery.ResourcePublicDNSNamespace(),
 			"aws_service_discovery_service":               servicediscovery.ResourceService(),
+			"aws_service_discovery_tag":                   servicediscovery.ResourceTag(), // lintignore:AWSR006
 
 			"aws_servicequotas_service_quota": servicequotas.ResourceServiceQuota(),
 
-			"aws_ses_active_receipt_rule_set":      ses.ResourceActiveReceiptRuleSet(),
+			"aws_ses_active_receipt_rule_set":      ses.ResourceActiveReceiptRuleSet(), // lintignore:AWSR006
 			"aws_ses_configuration_set":            ses.ResourceConfigurationSet(),
 			"aws_ses_domain_dkim":                  ses.ResourceDomainDKIM(),
 			"aws_ses_domain_identity":              ses.ResourceDomainIdentity(),
-			"aws_ses_domain_identity_verification": ses.ResourceDomainIdentityVerification(),
+			"aws_ses_domain_identity_verification": ses.ResourceDomainIdentityVerification(), // lintignore:AWSR006
 			"aws_ses_domain_mail_from":             ses.ResourceDomainMailFrom(),
 			"aws_ses_email_identity":               ses.ResourceEmailIdentity(),
 			"aws_ses_event_destination":            ses.ResourceEventDestination(),
 			"aws_ses_identity_notification_topic":  ses.ResourceIdentityNotificationTopic(),
 			"aws_ses_identity_policy":              ses.ResourceIdentityPolicy(),
 			"aws_ses_receipt_filter":               ses.ResourceReceiptFilter(),
-			"aws_ses_receipt_rule":                 ses.ResourceReceiptRule(),
+			"aws_ses_receipt_rule":                 ses.ResourceReceiptRule(), // lintignore:AWSR006
 			"aws_ses_receipt_rule_set":             ses.ResourceReceiptRuleSet(),
 			"aws_ses_template":                     ses.ResourceTemplate(),
 
 			"aws_sfn_activity":      sfn.ResourceActivity(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
+			"aws_sfn_tag":           sfn.ResourceTag(), // lintignore:AWSR006
 
 			"aws_shield_protection":       shield.ResourceProtection(),
 			"aws_shield_protection_group": shield.ResourceProtectionGroup(),
+			"aws_shield_tag":              shield.ResourceTag(), // lintignore:AWSR006
 
 			"aws_signer_signing_job":                signer.ResourceSigningJob(),
 			"aws_signer_signing_profile":            signer.ResourceSigningProfile(),
 			"aws_signer_signing_profile_permission": signer.ResourceSigningProfilePermission(),
+			"aws_signer_tag":                        signer.ResourceTag(), // lintignore:AWSR006
 
 			"aws_simpledb_domain": simpledb.ResourceDomain(),
 
 			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
-			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
+			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(), // lintignore:AWSR006
+			"aws_sns_tag":                  sns.ResourceTag(),            // lintignore:AWSR006
 			"aws_sns_topic":                sns.ResourceTopic(),
-			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(),
+			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(), // lintignore:AWSR006
 			"aws_sns_topic_subscription":   sns.ResourceTopicSubscription(),
 
 			"aws_sqs_queue":        sqs.ResourceQueue(),
-			"aws_sqs_queue_policy": sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue_policy": sqs.ResourceQueuePolicy(), // lintignore:AWSR006
+			"aws_sqs_tag":          sqs.ResourceTag(),         // lintignore:AWSR006
 
 			"aws_ssm_activation":                ssm.ResourceActivation(),
 			"aws_ssm_association":               ssm.ResourceAssociation(),
 			"aws_ssm_document":                  ssm.ResourceDocument(),
 			"aws_ssm_maintenance_window":        ssm.ResourceMaintenanceWindow(),
-			"aws_ssm_maintenance_window_target": ssm.ResourceMaintenanceWindowTarget(),
-			"aws_ssm_maintenance_window_task":   ssm.ResourceMaintenanceWindowTask(),
+			"aws_ssm_maintenance_window_target": ssm.ResourceMaintenanceWindowTarget(), // lintignore:AWSR006
+			"aws_ssm_maintenance_window_task":   ssm.ResourceMaintenanceWindowTask(),   // lintignore:AWSR006
 			"aws_ssm_parameter":                 ssm.ResourceParameter(),
 			"aws_ssm_patch_baseline":            ssm.ResourcePatchBaseline(),
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
@@ -1620,21 +1882,25 @@ func Provider() *schema.Provider {
 			"aws_storagegateway_nfs_file_share":          storagegateway.ResourceNFSFileShare(),
 			"aws_storagegateway_smb_file_share":          storagegateway.ResourceSMBFileShare(),
 			"aws_storagegateway_stored_iscsi_volume":     storagegateway.ResourceStorediSCSIVolume(),
+			"aws_storagegateway_tag":                     storagegateway.ResourceTag(), // lintignore:AWSR006
 			"aws_storagegateway_tape_pool":               storagegateway.ResourceTapePool(),
 			"aws_storagegateway_upload_buffer":           storagegateway.ResourceUploadBuffer(),
 			"aws_storagegateway_working_storage":         storagegateway.ResourceWorkingStorage(),
 
 			"aws_swf_domain": swf.ResourceDomain(),
+			"aws_swf_tag":    swf.ResourceTag(), // lintignore:AWSR006
 
 			"aws_synthetics_canary": synthetics.ResourceCanary(),
 
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
+			"aws_timestreamwrite_tag":      timestreamwrite.ResourceTag(), // lintignore:AWSR006
 
-			"aws_transfer_access":  transfer.ResourceAccess(),
+			"aws_transfer_access":  transfer.ResourceAccess(), // lintignore:AWSR006
 			"aws_transfer_server":  transfer.ResourceServer(),
-			"aws_transfer_ssh_key": transfer.ResourceSSHKey(),
-			"aws_transfer_user":    transfer.ResourceUser(),
+			"aws_transfer_ssh_key": transfer.ResourceSSHKey(), // lintignore:AWSR006
+			"aws_transfer_tag":     transfer.ResourceTag(),    // lintignore:AWSR006
+			"aws_transfer_user":    transfer.ResourceUser(),   // lintignore:AWSR006
 
 			"aws_waf_byte_match_set":          waf.ResourceByteMatchSet(),
 			"aws_waf_geo_match_set":           waf.ResourceGeoMatchSet(),
@@ -1646,6 +1912,7 @@ func Provider() *schema.Provider {
 			"aws_waf_rule_group":              waf.ResourceRuleGroup(),
 			"aws_waf_size_constraint_set":     waf.ResourceSizeConstraintSet(),
 			"aws_waf_sql_injection_match_set": waf.ResourceSQLInjectionMatchSet(),
+			"aws_waf_tag":                     waf.ResourceTag(), // lintignore:AWSR006
 			"aws_waf_web_acl":                 waf.ResourceWebACL(),
 			"aws_waf_xss_match_set":           waf.ResourceXSSMatchSet(),
 
@@ -1659,30 +1926,60 @@ func Provider() *schema.Provider {
 			"aws_wafregional_rule_group":              wafregional.ResourceRuleGroup(),
 			"aws_wafregional_size_constraint_set":     wafregional.ResourceSizeConstraintSet(),
 			"aws_wafregional_sql_injection_match_set": wafregional.ResourceSQLInjectionMatchSet(),
+			"aws_wafregional_tag":                     wafregional.ResourceTag(), // lintignore:AWSR006
 			"aws_wafregional_web_acl":                 wafregional.ResourceWebACL(),
-			"aws_wafregional_web_acl_association":     wafregional.ResourceWebACLAssociation(),
+			"aws_wafregional_web_acl_association":     wafregional.ResourceWebACLAssociation(), // lintignore:AWSR006
 			"aws_wafregional_xss_match_set":           wafregional.ResourceXSSMatchSet(),
 
 			"aws_wafv2_ip_set":                        wafv2.ResourceIPSet(),
 			"aws_wafv2_regex_pattern_set":             wafv2.ResourceRegexPatternSet(),
 			"aws_wafv2_rule_group":                    wafv2.ResourceRuleGroup(),
+			"aws_wafv2_tag":                           wafv2.ResourceTag(), // lintignore:AWSR006
 			"aws_wafv2_web_acl":                       wafv2.ResourceWebACL(),
-			"aws_wafv2_web_acl_association":           wafv2.ResourceWebACLAssociation(),
-			"aws_wafv2_web_acl_logging_configuration": wafv2.ResourceWebACLLoggingConfiguration(),
+			"aws_wafv2_web_acl_association":           wafv2.ResourceWebACLAssociation(),          // lintignore:AWSR006
+			"aws_wafv2_web_acl_logging_configuration": wafv2.ResourceWebACLLoggingConfiguration(), // lintignore:AWSR006
 
 			"aws_worklink_fleet": worklink.ResourceFleet(),
+			"aws_worklink_tag":   worklink.ResourceTag(), // lintignore:AWSR006
 			"aws_worklink_website_certificate_authority_association": worklink.ResourceWebsiteCertificateAuthorityAssociation(),
 
 			"aws_workspaces_directory": workspaces.ResourceDirectory(),
 			"aws_workspaces_ip_group":  workspaces.ResourceIPGroup(),
+			"aws_workspaces_tag":       workspaces.ResourceTag(), // lintignore:AWSR006
 			"aws_workspaces_workspace": workspaces.ResourceWorkspace(),
 
-			"aws_xray_encryption_config": xray.ResourceEncryptionConfig(),
+			"aws_xray_encryption_config": xray.ResourceEncryptionConfig(), // lintignore:AWSR006
 			"aws_xray_group":             xray.ResourceGroup(),
 			"aws_xray_sampling_rule":     xray.ResourceSamplingRule(),
+			"aws_xray_tag":               xray.ResourceTag(), // lintignore:AWSR006
 		},
 	}
 
+	// Make the resource type available to CustomizeDiff functions, such as
+	// verify.SetTagsDiff, for resource type specific handling, and to CRUD
+	// functions, for example to describe conns.GlobalMutexKV lock holders.
+	for resourceType, r := range provider.ResourcesMap {
+		if r.CustomizeDiff != nil {
+			r.CustomizeDiff = customizeDiffWithResourceType(resourceType, r.CustomizeDiff)
+		}
+
+		if r.CreateContext != nil {
+			r.CreateContext = crudWithResourceType(resourceType, r.CreateContext)
+		}
+
+		if r.ReadContext != nil {
+			r.ReadContext = crudWithResourceType(resourceType, r.ReadContext)
+		}
+
+		if r.UpdateContext != nil {
+			r.UpdateContext = crudWithResourceType(resourceType, r.UpdateContext)
+		}
+
+		if r.DeleteContext != nil {
+			r.DeleteContext = crudWithResourceType(resourceType, r.DeleteContext)
+		}
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1722,9 +2019,30 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"retry_mode": "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
+			"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+
+		"api_audit_log": "Path of a file to which a JSON line describing each AWS API operation is appended.",
+
+		"read_only": "Reject API operations that may modify resources. " +
+			"Only operations named Describe*, Get* and List*, and known read-only operations, are allowed.",
+
+		"read_only_allowed_operations": "Additional API operations, in `<service>:<operation>` form, " +
+			"to allow when `read_only` is set.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
+		"custom_ca_bundle": "File containing custom root and intermediate certificates. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"ec2_metadata_service_endpoint": "Address of the EC2 metadata service endpoint to use. " +
+			"Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
+
+		"ec2_metadata_service_endpoint_mode": "Protocol to use with EC2 metadata service endpoint. " +
+			"Valid values are `IPv4` and `IPv6`. Can also be configured using the " +
+			"`AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
@@ -1749,45 +2067,89 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability",
 	}
 }
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
-	config := conns.Config{
-		AccessKey:               d.Get("access_key").(string),
-		SecretKey:               d.Get("secret_key").(string),
-		Profile:                 d.Get("profile").(string),
-		Token:                   d.Get("token").(string),
-		Region:                  d.Get("region").(string),
-		CredsFilename:           d.Get("shared_credentials_file").(string),
-		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
-		Endpoints:               make(map[string]string),
-		MaxRetries:              d.Get("max_retries").(int),
-		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
-		Insecure:                d.Get("insecure").(bool),
-		HTTPProxy:               d.Get("http_proxy").(string),
-		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
-		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
-		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
-		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
-		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
-		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
-		TerraformVersion:        terraformVersion,
+	config, err := expandProviderConfig(d, terraformVersion)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return config.Client()
+}
+
+// expandProviderConfig returns the AWS client configuration for the provider
+// configuration.
+func expandProviderConfig(d *schema.ResourceData, terraformVersion string) (*conns.Config, error) {
+	config := &conns.Config{
+		AccessKey:                      d.Get("access_key").(string),
+		SecretKey:                      d.Get("secret_key").(string),
+		Profile:                        d.Get("profile").(string),
+		Token:                          d.Get("token").(string),
+		Region:                         d.Get("region").(string),
+		CredsFilename:                  d.Get("shared_credentials_file").(string),
+		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		DefaultTagsConfig:              expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
+		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		Endpoints:                      make(map[string]string),
+		MaxRetries:                     d.Get("max_retries").(int),
+		RetryMode:                      d.Get("retry_mode").(string),
+		ReadOnly:                       d.Get("read_only").(bool),
+		APIAuditLog:                    d.Get("api_audit_log").(string),
+		Insecure:                       d.Get("insecure").(bool),
+		HTTPProxy:                      d.Get("http_proxy").(string),
+		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipGetEC2Platforms:            d.Get("skip_get_ec2_platforms").(bool),
+		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
+		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipMetadataApiCheck:           d.Get("skip_metadata_api_check").(bool),
+		S3ForcePathStyle:               d.Get("s3_force_path_style").(bool),
+		TerraformVersion:               terraformVersion,
+		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
+		assumeRole := expandProviderAssumeRole(l[0].(map[string]interface{}))
 
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
-		}
+		config.AssumeRoleARN = assumeRole.RoleARN
+		config.AssumeRoleDurationSeconds = assumeRole.DurationSeconds
+		config.AssumeRoleExternalID = assumeRole.ExternalID
+		config.AssumeRolePolicy = assumeRole.Policy
+		config.AssumeRolePolicyARNs = assumeRole.PolicyARNs
+		config.AssumeRoleSessionName = assumeRole.SessionName
+		config.AssumeRoleTags = assumeRole.Tags
+		config.AssumeRoleTransitiveTagKeys = assumeRole.TransitiveTagKeys
+
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+
+		// Any further roles are assumed in order with the credentials of the previous role.
+		for _, tfMapRaw := range l[1:] {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
 
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
+			if !ok {
+				tfMap = map[string]interface{}{}
+			}
+
+			assumeRole := expandProviderAssumeRole(tfMap)
+			config.AssumeRoleChain = append(config.AssumeRoleChain, assumeRole)
+
+			log.Printf("[INFO] assume_role chain configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
 		}
+	}
 
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleWithWebIdentityDurationSeconds = v
 		}
 
 		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
@@ -1798,45 +2160,27 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 					continue
 				}
 
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
+				config.AssumeRoleWithWebIdentityPolicyARNs = append(config.AssumeRoleWithWebIdentityPolicyARNs, policyARN)
 			}
 		}
 
 		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
+			config.AssumeRoleWithWebIdentityRoleARN = v
 		}
 
 		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
+			config.AssumeRoleWithWebIdentitySessionName = v
 		}
 
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
-
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTags[k] = v
-			}
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
 		}
 
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
-			}
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
 		}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentityRoleARN, config.AssumeRoleWithWebIdentitySessionName)
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -1857,6 +2201,22 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	ignoreTagsConfig, err := expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	config.IgnoreTagsConfig = ignoreTagsConfig
+
+	requiredTagsConfig, err := expandProviderRequiredTags(d.Get("required_tags").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	config.RequiredTagsConfig = requiredTagsConfig
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1869,14 +2229,19 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
-	return config.Client()
+	if v, ok := d.GetOk("read_only_allowed_operations"); ok {
+		for _, operation := range v.(*schema.Set).List() {
+			config.ReadOnlyAllowedOperations = append(config.ReadOnlyAllowedOperations, operation.(string))
+		}
+	}
+
+	return config, nil
 }
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -1932,6 +2297,121 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func expandProviderAssumeRole(m map[string]interface{}) *conns.AssumeRole {
+	assumeRole := &conns.AssumeRole{}
+
+	if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+		assumeRole.DurationSeconds = v
+	}
+
+	if v, ok := m["external_id"].(string); ok && v != "" {
+		assumeRole.ExternalID = v
+	}
+
+	if v, ok := m["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+		for _, policyARNRaw := range policyARNSet.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
+		assumeRole.Tags = make(map[string]string)
+
+		for k, vRaw := range tagMapRaw {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.Tags[k] = v
+		}
+	}
+
+	if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
+		for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
+			transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.TransitiveTagKeys = append(assumeRole.TransitiveTagKeys, transitiveTagKey)
+		}
+	}
+
+	return assumeRole
+}
+
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Seconds to restrict the assume role session duration.",
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume with a web identity token prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Path to a file containing an OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1967,9 +2447,68 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	return defaultConfig
 }
 
-func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
+func expandProviderRequiredTags(l []interface{}) (*tftags.RequiredConfig, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{}
+	m := l[0].(map[string]interface{})
+
+	for _, tfMapRaw := range m["tag"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		requiredTag := &tftags.RequiredTag{
+			Key: tfMap["key"].(string),
+		}
+
+		if v, ok := tfMap["allowed_values"].(*schema.Set); ok {
+			for _, pattern := range v.List() {
+				re, err := regexp.Compile(pattern.(string))
+
+				if err != nil {
+					return nil, fmt.Errorf("error compiling required tag (%s) allowed value (%s): %w", requiredTag.Key, pattern, err)
+				}
+
+				requiredTag.AllowedValues = append(requiredTag.AllowedValues, re)
+			}
+		}
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+			for _, resourceType := range v.List() {
+				requiredTag.ExcludeResourceTypes = append(requiredTag.ExcludeResourceTypes, resourceType.(string))
+			}
+		}
+
+		requiredConfig.Tags = append(requiredConfig.Tags, requiredTag)
+	}
+
+	return requiredConfig, nil
+}
+
+// customizeDiffWithResourceType returns a CustomizeDiff function that calls
+// customizeDiff with the resource type carried by the context.
+func customizeDiffWithResourceType(resourceType string, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		return customizeDiff(conns.NewResourceTypeContext(ctx, resourceType), diff, meta)
+	}
+}
+
+// crudWithResourceType returns a CRUD function that calls crud with the
+// resource type carried by the context.
+func crudWithResourceType(resourceType string, crud func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return crud(conns.NewResourceTypeContext(ctx, resourceType), d, meta)
+	}
+}
+
+func expandProviderIgnoreTags(l []interface{}) (*tftags.IgnoreConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
 	}
 
 	ignoreConfig := &tftags.IgnoreConfig{}
@@ -1983,5 +2522,40 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
-	return ignoreConfig
+	if v, ok := m["key_patterns"].(*schema.Set); ok {
+		for _, pattern := range v.List() {
+			re, err := regexp.Compile(pattern.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("error compiling ignore tags key pattern (%s): %w", pattern, err)
+			}
+
+			ignoreConfig.KeyPatterns = append(ignoreConfig.KeyPatterns, re)
+		}
+	}
+
+	if v, ok := m["value_pattern"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			key := tfMap["key"].(string)
+			pattern := tfMap["pattern"].(string)
+			re, err := regexp.Compile(pattern)
+
+			if err != nil {
+				return nil, fmt.Errorf("error compiling ignore tags (%s) value pattern (%s): %w", key, pattern, err)
+			}
+
+			ignoreConfig.ValuePatterns = append(ignoreConfig.ValuePatterns, &tftags.IgnoreValuePattern{
+				Key:     key,
+				Pattern: re,
+			})
+		}
+	}
+
+	return ignoreConfig, nil
 }
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestExpandProviderConfig_retryMode(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Env      string
+		Raw      map[string]interface{}
+		Expected string
+	}{
+		{
+			Name:     "default",
+			Raw:      map[string]interface{}{},
+			Expected: "",
+		},
+		{
+			Name: "standard",
+			Raw: map[string]interface{}{
+				"retry_mode": conns.RetryModeStandard,
+			},
+			Expected: conns.RetryModeStandard,
+		},
+		{
+			Name: "adaptive",
+			Raw: map[string]interface{}{
+				"retry_mode": conns.RetryModeAdaptive,
+			},
+			Expected: conns.RetryModeAdaptive,
+		},
+		{
+			Name:     "environment",
+			Env:      conns.RetryModeAdaptive,
+			Raw:      map[string]interface{}{},
+			Expected: conns.RetryModeAdaptive,
+		},
+	}
+
+	if v, ok := os.LookupEnv("AWS_RETRY_MODE"); ok {
+		defer os.Setenv("AWS_RETRY_MODE", v)
+	} else {
+		defer os.Unsetenv("AWS_RETRY_MODE")
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if testCase.Env != "" {
+				os.Setenv("AWS_RETRY_MODE", testCase.Env)
+			} else {
+				os.Unsetenv("AWS_RETRY_MODE")
+			}
+
+			d := schema.TestResourceDataRaw(t, Provider().Schema, testCase.Raw)
+
+			config, err := expandProviderConfig(d, "0.0.0")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := config.RetryMode, testCase.Expected; got != expected {
+				t.Errorf("got retry mode %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestCRUDWithResourceType(t *testing.T) {
+	var got string
+
+	crud := crudWithResourceType("aws_example", func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		got, _ = conns.ResourceTypeFromContext(ctx)
+
+		return nil
+	})
+
+	if diags := crud(context.Background(), nil, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if expected := "aws_example"; got != expected {
+		t.Errorf("got resource type %q, expected %q", got, expected)
+	}
+}
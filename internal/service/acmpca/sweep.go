@@ -24,11 +24,15 @@ func init() {
 }
 
 func sweepCertificateAuthorities(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_acmpca_certificate_authority", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ACMPCAConn
+	conn := client.(*conns.AWSClient).ACMPCAConn()
 
 	certificateAuthorities, err := listCertificateAuthorities(conn)
 	if err != nil {
@@ -40,6 +40,11 @@ func TestAccAmplify_serial(t *testing.T) {
 			"disappears": testAccDomainAssociation_disappears,
 			"update":     testAccDomainAssociation_update,
 		},
+		"Tag": {
+			"basic":      testAccTag_basic,
+			"disappears": testAccTag_disappears,
+			"value":      testAccTag_value,
+		},
 		"Webhook": {
 			"basic":      testAccWebhook_basic,
 			"disappears": testAccWebhook_disappears,
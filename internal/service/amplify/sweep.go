@@ -23,11 +23,15 @@ func init() {
 }
 
 func sweepApps(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_amplify_app", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).AmplifyConn
+	conn := client.(*conns.AWSClient).AmplifyConn()
 	input := &amplify.ListAppsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -37,11 +37,15 @@ func init() {
 }
 
 func sweepAPIs(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_apigatewayv2_api", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	input := &apigatewayv2.GetApisInput{}
 	var sweeperErrs *multierror.Error
 
@@ -81,11 +85,15 @@ func sweepAPIs(region string) error {
 }
 
 func sweepDomainNames(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_apigatewayv2_domain_name", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	input := &apigatewayv2.GetDomainNamesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -123,11 +131,15 @@ func sweepDomainNames(region string) error {
 }
 
 func sweepVPCLinks(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_apigatewayv2_vpc_link", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	input := &apigatewayv2.GetVpcLinksInput{}
 	var sweeperErrs *multierror.Error
 
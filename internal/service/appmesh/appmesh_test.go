@@ -34,6 +34,11 @@ func TestAccAppMesh_serial(t *testing.T) {
 			"tcpRouteTimeout":     testAccRoute_tcpRouteTimeout,
 			"tags":                testAccRoute_tags,
 		},
+		"Tag": {
+			"basic":      testAccTag_basic,
+			"disappears": testAccTag_disappears,
+			"value":      testAccTag_value,
+		},
 		"VirtualGateway": {
 			"basic":                      testAccVirtualGateway_basic,
 			"disappears":                 testAccVirtualGateway_disappears,
@@ -65,11 +65,15 @@ func init() {
 }
 
 func sweepGatewayRoutes(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_appmesh_gateway_route", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppMeshConn
+	conn := client.(*conns.AWSClient).AppMeshConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -143,11 +147,15 @@ func sweepGatewayRoutes(region string) error {
 }
 
 func sweepMeshes(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_appmesh_mesh", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).AppMeshConn
+	conn := client.(*conns.AWSClient).AppMeshConn()
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
@@ -183,11 +191,15 @@ func sweepMeshes(region string) error {
 }
 
 func sweepRoutes(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_appmesh_route", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).AppMeshConn
+	conn := client.(*conns.AWSClient).AppMeshConn()
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
@@ -263,11 +275,15 @@ func sweepRoutes(region string) error {
 }
 
 func sweepVirtualGateways(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_appmesh_virtual_gateway", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppMeshConn
+	conn := client.(*conns.AWSClient).AppMeshConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -324,11 +340,15 @@ func sweepVirtualGateways(region string) error {
 }
 
 func sweepVirtualNodes(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_appmesh_virtual_node", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppMeshConn
+	conn := client.(*conns.AWSClient).AppMeshConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -388,11 +408,15 @@ func sweepVirtualNodes(region string) error {
 }
 
 func sweepVirtualRouters(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_appmesh_virtual_router", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).AppMeshConn
+	conn := client.(*conns.AWSClient).AppMeshConn()
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
@@ -447,11 +471,15 @@ func sweepVirtualRouters(region string) error {
 }
 
 func sweepVirtualServices(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_appmesh_virtual_service", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).AppMeshConn
+	conn := client.(*conns.AWSClient).AppMeshConn()
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
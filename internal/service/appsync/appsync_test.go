@@ -67,6 +67,11 @@ func TestAccAppSync_serial(t *testing.T) {
 			"pipeline":          testAccAppSyncResolver_pipeline,
 			"caching":           testAccAppSyncResolver_caching,
 		},
+		"Tag": {
+			"basic":      testAccAppSyncTag_basic,
+			"disappears": testAccAppSyncTag_disappears,
+			"value":      testAccAppSyncTag_value,
+		},
 	}
 
 	for group, m := range testCases {
@@ -22,11 +22,15 @@ func init() {
 }
 
 func sweepGraphQLAPIs(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_appsync_graphql_api", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).AppSyncConn
+	conn := client.(*conns.AWSClient).AppSyncConn()
 
 	input := &appsync.ListGraphqlApisInput{}
 
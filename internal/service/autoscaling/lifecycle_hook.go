@@ -90,7 +90,7 @@ func resourceLifecycleHookPutOp(conn *autoscaling.AutoScaling, params *autoscali
 }
 
 func resourceLifecycleHookPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	params := getPutLifecycleHookInput(d)
 
 	if err := resourceLifecycleHookPutOp(conn, &params); err != nil {
@@ -127,7 +127,7 @@ func resourceLifecycleHookRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLifecycleHookDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	p, err := getLifecycleHook(d, meta)
 	if err != nil {
 		return err
@@ -181,7 +181,7 @@ func getPutLifecycleHookInput(d *schema.ResourceData) autoscaling.PutLifecycleHo
 }
 
 func getLifecycleHook(d *schema.ResourceData, meta interface{}) (*autoscaling.LifecycleHook, error) {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	params := autoscaling.DescribeLifecycleHooksInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
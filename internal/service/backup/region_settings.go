@@ -30,7 +30,7 @@ func ResourceRegionSettings() *schema.Resource {
 }
 
 func resourceRegionSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	prefrences := d.Get("resource_type_opt_in_preference").(map[string]interface{})
 	list := make(map[string]*bool, len(prefrences))
@@ -53,7 +53,7 @@ func resourceRegionSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceRegionSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	resp, err := conn.DescribeRegionSettings(&backup.DescribeRegionSettingsInput{})
 	if err != nil {
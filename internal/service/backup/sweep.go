@@ -6,6 +6,7 @@ package backup
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
@@ -49,7 +50,7 @@ func sweepVaultLockConfiguration(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -69,7 +70,9 @@ func sweepVaultLockConfiguration(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(vault.BackupVaultName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(vault.CreationDate)).
+				WithName(aws.StringValue(vault.BackupVaultName)))
 		}
 
 		return !lastPage
@@ -98,7 +101,7 @@ func sweepVaultNotifications(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -118,7 +121,9 @@ func sweepVaultNotifications(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(vault.BackupVaultName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(vault.CreationDate)).
+				WithName(aws.StringValue(vault.BackupVaultName)))
 		}
 
 		return !lastPage
@@ -145,7 +150,7 @@ func sweepVaultPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	input := &backup.ListBackupVaultsInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -160,7 +165,9 @@ func sweepVaultPolicies(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(vault.BackupVaultName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(vault.CreationDate)).
+				WithName(aws.StringValue(vault.BackupVaultName)))
 		}
 
 		return !lastPage
@@ -188,11 +195,17 @@ func sweepVaults(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	input := &backup.ListBackupVaultsInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
 
+	filter, err := sweep.DefaultSweepFilter()
+
+	if err != nil {
+		return err
+	}
+
 	err = conn.ListBackupVaultsPages(input, func(page *backup.ListBackupVaultsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
@@ -201,6 +214,21 @@ func sweepVaults(region string) error {
 		for _, vault := range page.BackupVaultList {
 			failedToDeleteRecoveryPoint := false
 			name := aws.StringValue(vault.BackupVaultName)
+
+			r := ResourceVault()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResource := sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(vault.CreationDate)).
+				WithName(name)
+
+			// Recovery points are deleted directly, so only from vaults that pass the sweep filter.
+			if reason := filter.SkipReason(sweepResource, time.Now()); reason != "" {
+				log.Printf("[INFO] Skipping Backup Vault (%s): %s", name, reason)
+				continue
+			}
+
 			input := &backup.ListRecoveryPointsByBackupVaultInput{
 				BackupVaultName: aws.String(name),
 			}
@@ -245,11 +273,7 @@ func sweepVaults(region string) error {
 				continue
 			}
 
-			r := ResourceVault()
-			d := r.Data(nil)
-			d.SetId(name)
-
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweepResource)
 		}
 
 		return !lastPage
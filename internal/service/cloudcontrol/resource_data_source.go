@@ -43,7 +43,7 @@ func DataSourceResource() *schema.Resource {
 }
 
 func dataSourceResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudControlConn
+	conn := meta.(*conns.AWSClient).CloudControlConn()
 
 	identifier := d.Get("identifier").(string)
 	resourceDescription, err := FindResourceByID(ctx, conn,
@@ -0,0 +1,130 @@
+package cloudcontrol
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"properties": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON string matching the CloudFormation resource type schema with the resource's current configuration. Use the jsondecode() function to access its attributes.",
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+			},
+			"type_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn()
+
+	typeName := d.Get("type_name").(string)
+	resourceDescriptions, err := FindResources(ctx, conn,
+		typeName,
+		d.Get("type_version_id").(string),
+		d.Get("role_arn").(string),
+		d.Get("resource_model").(string),
+	)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Cloud Control API Resources (%s): %w", typeName, err))
+	}
+
+	resources, err := flattenResourceDescriptions(resourceDescriptions)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Cloud Control API Resources (%s): %w", typeName, err))
+	}
+
+	var identifiers []string
+
+	for _, resourceDescription := range resourceDescriptions {
+		identifiers = append(identifiers, aws.StringValue(resourceDescription.Identifier))
+	}
+
+	d.SetId(typeName)
+
+	d.Set("identifiers", identifiers)
+
+	if err := d.Set("resources", resources); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting resources: %w", err))
+	}
+
+	return nil
+}
+
+func flattenResourceDescriptions(apiObjects []*cloudcontrolapi.ResourceDescription) ([]interface{}, error) {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"identifier": aws.StringValue(apiObject.Identifier),
+		}
+
+		if v := aws.StringValue(apiObject.Properties); v != "" {
+			properties, err := structure.NormalizeJsonString(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("error decoding properties of resource (%s): %w", aws.StringValue(apiObject.Identifier), err)
+			}
+
+			tfMap["properties"] = properties
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
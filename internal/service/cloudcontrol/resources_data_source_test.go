@@ -0,0 +1,152 @@
+package cloudcontrol_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+)
+
+func TestFindResources(t *testing.T) {
+	pages := []map[string]interface{}{
+		{
+			"NextToken": "page2",
+			"ResourceDescriptions": []map[string]string{
+				{"Identifier": "one", "Properties": `{"LogGroupName":"one"}`},
+				{"Identifier": "two", "Properties": `{"LogGroupName":"two"}`},
+			},
+		},
+		{
+			"ResourceDescriptions": []map[string]string{
+				{"Identifier": "three", "Properties": `{"LogGroupName":"three"}`},
+			},
+		},
+	}
+
+	var inputs []map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("X-Amz-Target"), "CloudApiService.ListResources"; got != want {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"__type":"InvalidRequestException","message":"unexpected target %s"}`, got)
+			return
+		}
+
+		var input map[string]interface{}
+
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"__type":"InvalidRequestException","message":%q}`, err)
+			return
+		}
+
+		inputs = append(inputs, input)
+
+		page := pages[0]
+		if input["NextToken"] == "page2" {
+			page = pages[1]
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		json.NewEncoder(w).Encode(page) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := cloudcontrolapi.New(sess)
+
+	output, err := tfcloudcontrol.FindResources(context.Background(), conn, "AWS::Logs::LogGroup", "", "", `{"LogGroupName":"one"}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output), 3; got != want {
+		t.Fatalf("expected %d resources, got %d", want, got)
+	}
+
+	for i, want := range []string{"one", "two", "three"} {
+		if got := aws.StringValue(output[i].Identifier); got != want {
+			t.Errorf("resource %d: expected identifier %q, got %q", i, want, got)
+		}
+	}
+
+	if got, want := len(inputs), 2; got != want {
+		t.Fatalf("expected %d requests, got %d", want, got)
+	}
+
+	for i, input := range inputs {
+		if got, want := input["TypeName"], "AWS::Logs::LogGroup"; got != want {
+			t.Errorf("request %d: expected TypeName %q, got %q", i, want, got)
+		}
+
+		if got, want := input["ResourceModel"], `{"LogGroupName":"one"}`; got != want {
+			t.Errorf("request %d: expected ResourceModel %q, got %q", i, want, got)
+		}
+
+		if _, ok := input["RoleArn"]; ok {
+			t.Errorf("request %d: unexpected RoleArn", i)
+		}
+	}
+}
+
+func TestAccCloudControlResourcesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "type_name", resourceName, "type_name"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "identifiers.*", resourceName, "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resources.*.identifier", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+}
+
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+
+  depends_on = [aws_cloudcontrolapi_resource.test]
+}
+`, rName)
+}
@@ -85,7 +85,7 @@ func sweepCachePolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListCachePoliciesInput{
 		Type: aws.String(cloudfront.ResponseHeadersPolicyTypeCustom),
 	}
@@ -115,7 +115,8 @@ func sweepCachePolicies(region string) error {
 			d.SetId(id)
 			d.Set("etag", output.ETag)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithName(aws.StringValue(output.CachePolicy.CachePolicyConfig.Name)))
 		}
 
 		return !lastPage
@@ -140,11 +141,15 @@ func sweepCachePolicies(region string) error {
 }
 
 func sweepDistributions(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudfront_distribution", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 
 	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
 
@@ -194,11 +199,15 @@ func sweepDistributions(region string) error {
 }
 
 func sweepFunctions(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudfront_function", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListFunctionsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -253,11 +262,15 @@ func sweepFunctions(region string) error {
 }
 
 func sweepKeyGroup(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudfront_key_group", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	var sweeperErrs *multierror.Error
 
 	input := &cloudfront.ListKeyGroupsInput{}
@@ -302,11 +315,15 @@ func sweepKeyGroup(region string) error {
 }
 
 func sweepMonitoringSubscriptions(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudfront_monitoring_subscription", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	var sweeperErrs *multierror.Error
 
 	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
@@ -350,11 +367,15 @@ func sweepMonitoringSubscriptions(region string) error {
 }
 
 func sweepRealtimeLogsConfig(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudfront_realtime_log_config", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListRealtimeLogConfigsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -401,7 +422,7 @@ func sweepFieldLevelEncryptionConfigs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListFieldLevelEncryptionConfigsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -458,7 +479,7 @@ func sweepFieldLevelEncryptionProfiles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListFieldLevelEncryptionProfilesInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -486,7 +507,8 @@ func sweepFieldLevelEncryptionProfiles(region string) error {
 			d.SetId(id)
 			d.Set("etag", output.ETag)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithName(aws.StringValue(output.FieldLevelEncryptionProfile.FieldLevelEncryptionProfileConfig.Name)))
 		}
 
 		return !lastPage
@@ -515,7 +537,7 @@ func sweepOriginRequestPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListOriginRequestPoliciesInput{
 		Type: aws.String(cloudfront.ResponseHeadersPolicyTypeCustom),
 	}
@@ -545,7 +567,8 @@ func sweepOriginRequestPolicies(region string) error {
 			d.SetId(id)
 			d.Set("etag", output.ETag)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithName(aws.StringValue(output.OriginRequestPolicy.OriginRequestPolicyConfig.Name)))
 		}
 
 		return !lastPage
@@ -574,7 +597,7 @@ func sweepResponseHeadersPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListResponseHeadersPoliciesInput{
 		Type: aws.String(cloudfront.ResponseHeadersPolicyTypeCustom),
 	}
@@ -604,7 +627,8 @@ func sweepResponseHeadersPolicies(region string) error {
 			d.SetId(id)
 			d.Set("etag", output.ETag)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithName(aws.StringValue(output.ResponseHeadersPolicy.ResponseHeadersPolicyConfig.Name)))
 		}
 
 		return !lastPage
@@ -18,6 +18,11 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 			"disappears_Cluster": testAccHSM_disappears_Cluster,
 			"ipAddress":          testAccHSM_IPAddress,
 		},
+		"Tag": {
+			"basic":      testAccTag_basic,
+			"disappears": testAccTag_disappears,
+			"value":      testAccTag_value,
+		},
 	}
 
 	for group, m := range testCases {
@@ -179,7 +179,7 @@ resource "aws_cloudhsm_v2_cluster" "test" {
 }
 
 func testAccCheckClusterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudhsm_v2_cluster" {
@@ -201,7 +201,7 @@ func testAccCheckClusterDestroy(s *terraform.State) error {
 
 func testAccCheckClusterExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Conn()
 		it, ok := s.RootModule().Resources[name]
 		if !ok {
 			return fmt.Errorf("Not found: %s", name)
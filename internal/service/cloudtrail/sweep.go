@@ -24,11 +24,15 @@ func init() {
 }
 
 func sweeps(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudtrail", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudTrailConn
+	conn := client.(*conns.AWSClient).CloudTrailConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListTrailsPages(&cloudtrail.ListTrailsInput{}, func(page *cloudtrail.ListTrailsOutput, lastPage bool) bool {
@@ -25,12 +25,16 @@ func init() {
 }
 
 func sweepCompositeAlarms(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudwatch_composite_alarm", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CloudWatchConn
+	conn := client.(*conns.AWSClient).CloudWatchConn()
 	ctx := context.Background()
 
 	input := &cloudwatch.DescribeAlarmsInput{
@@ -40,6 +40,11 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"owner":             testAccCodeArtifactRepositoryPermissionsPolicy_owner,
 			"Disappears_domain": testAccCodeArtifactRepositoryPermissionsPolicy_Disappears_domain,
 		},
+		"Tag": {
+			"basic":      testAccCodeArtifactTag_basic,
+			"disappears": testAccCodeArtifactTag_disappears,
+			"value":      testAccCodeArtifactTag_value,
+		},
 	}
 
 	for group, m := range testCases {
@@ -62,7 +62,7 @@ func ResourceSourceCredential() *schema.Resource {
 }
 
 func resourceSourceCredentialCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeBuildConn
+	conn := meta.(*conns.AWSClient).CodeBuildConn()
 
 	authType := d.Get("auth_type").(string)
 
@@ -87,7 +87,7 @@ func resourceSourceCredentialCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceSourceCredentialRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeBuildConn
+	conn := meta.(*conns.AWSClient).CodeBuildConn()
 
 	resp, err := conn.ListSourceCredentials(&codebuild.ListSourceCredentialsInput{})
 	if err != nil {
@@ -117,7 +117,7 @@ func resourceSourceCredentialRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceSourceCredentialDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeBuildConn
+	conn := meta.(*conns.AWSClient).CodeBuildConn()
 
 	deleteOpts := &codebuild.DeleteSourceCredentialsInput{
 		Arn: aws.String(d.Id()),
@@ -23,13 +23,17 @@ func init() {
 }
 
 func sweepReportGroups(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_codebuild_report_group", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CodeBuildConn
+	conn := client.(*conns.AWSClient).CodeBuildConn()
 	input := &codebuild.ListReportGroupsInput{}
 	var sweeperErrs *multierror.Error
 
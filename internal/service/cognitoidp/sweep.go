@@ -30,11 +30,15 @@ func init() {
 }
 
 func sweepUserPoolDomains(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cognito_user_pool_domain", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CognitoIDPConn
+	conn := client.(*conns.AWSClient).CognitoIDPConn()
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(50),
@@ -82,11 +86,15 @@ func sweepUserPoolDomains(region string) error {
 }
 
 func sweepUserPools(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cognito_user_pool", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CognitoIDPConn
+	conn := client.(*conns.AWSClient).CognitoIDPConn()
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(50),
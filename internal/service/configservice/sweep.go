@@ -42,11 +42,15 @@ func init() {
 }
 
 func sweepAggregateAuthorizations(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_config_aggregate_authorization", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ConfigServiceConn
+	conn := client.(*conns.AWSClient).ConfigServiceConn()
 
 	aggregateAuthorizations, err := DescribeAggregateAuthorizations(conn)
 	if err != nil {
@@ -79,11 +83,15 @@ func sweepAggregateAuthorizations(region string) error {
 }
 
 func sweepConfigurationAggregators(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_config_configuration_aggregator", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ConfigServiceConn
+	conn := client.(*conns.AWSClient).ConfigServiceConn()
 
 	resp, err := conn.DescribeConfigurationAggregators(&configservice.DescribeConfigurationAggregatorsInput{})
 	if err != nil {
@@ -117,11 +125,15 @@ func sweepConfigurationAggregators(region string) error {
 }
 
 func sweepConfigurationRecorder(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_config_configuration_recorder", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ConfigServiceConn
+	conn := client.(*conns.AWSClient).ConfigServiceConn()
 
 	req := &configservice.DescribeConfigurationRecordersInput{}
 	resp, err := conn.DescribeConfigurationRecorders(req)
@@ -160,11 +172,15 @@ func sweepConfigurationRecorder(region string) error {
 }
 
 func sweepDeliveryChannels(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_config_delivery_channel", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ConfigServiceConn
+	conn := client.(*conns.AWSClient).ConfigServiceConn()
 
 	req := &configservice.DescribeDeliveryChannelsInput{}
 	var resp *configservice.DescribeDeliveryChannelsOutput
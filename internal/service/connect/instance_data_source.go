@@ -83,7 +83,7 @@ func DataSourceInstance() *schema.Resource {
 }
 
 func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	var matchedInstance *connect.Instance
 
@@ -96,7 +96,7 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta in
 
 		log.Printf("[DEBUG] Reading Connect Instance by instance_id: %s", input)
 
-		output, err := conn.DescribeInstance(&input)
+		output, err := conn.DescribeInstanceWithContext(ctx, &input)
 
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error getting Connect Instance by instance_id (%s): %w", instanceId, err))
@@ -24,6 +24,10 @@ func init() {
 }
 
 func sweepReportDefinitions(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cur_report_definition", region) {
+		return nil
+	}
+
 	c, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -37,7 +41,7 @@ func sweepReportDefinitions(region string) error {
 		}
 	}
 
-	conn := client.CURConn
+	conn := client.CURConn()
 
 	input := &cur.DescribeReportDefinitionsInput{}
 	var sweeperErrs *multierror.Error
@@ -54,11 +54,15 @@ func init() {
 }
 
 func sweepAgents(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_datasync_agent", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListAgentsInput{}
 	for {
@@ -108,11 +112,15 @@ func sweepAgents(region string) error {
 }
 
 func sweepLocationEFSs(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_datasync_location_efs", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -165,11 +173,15 @@ func sweepLocationEFSs(region string) error {
 }
 
 func sweepLocationFSxWindows(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_datasync_location_fsx_windows_file_system", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -222,11 +234,15 @@ func sweepLocationFSxWindows(region string) error {
 }
 
 func sweepLocationNFSs(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_datasync_location_nfs", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -278,11 +294,15 @@ func sweepLocationNFSs(region string) error {
 }
 
 func sweepLocationS3s(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_datasync_location_s3", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -335,11 +355,15 @@ func sweepLocationS3s(region string) error {
 }
 
 func sweepLocationSMBs(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_datasync_location_smb", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListLocationsInput{}
 	for {
@@ -391,11 +415,15 @@ func sweepLocationSMBs(region string) error {
 }
 
 func sweepTasks(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_datasync_task", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).DataSyncConn
+	conn := client.(*conns.AWSClient).DataSyncConn()
 
 	input := &datasync.ListTasksInput{}
 	for {
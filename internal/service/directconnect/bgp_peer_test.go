@@ -41,7 +41,7 @@ func TestAccDirectConnectBGPPeer_basic(t *testing.T) {
 }
 
 func testAccCheckBGPPeerDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_bgp_peer" {
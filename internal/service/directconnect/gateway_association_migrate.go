@@ -76,7 +76,7 @@ func resourceGatewayAssociationResourceV0() *schema.Resource {
 }
 
 func GatewayAssociationStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	conn := meta.(*conns.AWSClient).DirectConnectConn
+	conn := meta.(*conns.AWSClient).DirectConnectConn()
 
 	log.Println("[INFO] Found Direct Connect Gateway Association state v0; migrating to v1")
 
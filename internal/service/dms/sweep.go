@@ -37,7 +37,7 @@ func sweepReplicationInstances(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).DMSConn
+	conn := client.(*conns.AWSClient).DMSConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -48,7 +48,9 @@ func sweepReplicationInstances(region string) error {
 			d.Set("replication_instance_arn", instance.ReplicationInstanceArn)
 			d.SetId(aws.StringValue(instance.ReplicationInstanceIdentifier))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(instance.InstanceCreateTime)).
+				WithName(aws.StringValue(instance.ReplicationInstanceIdentifier)))
 		}
 
 		return !lastPage
@@ -77,7 +79,7 @@ func sweepReplicationTasks(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).DMSConn
+	conn := client.(*conns.AWSClient).DMSConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -91,7 +93,9 @@ func sweepReplicationTasks(region string) error {
 			d.SetId(aws.StringValue(instance.ReplicationTaskIdentifier))
 			d.Set("replication_task_arn", instance.ReplicationTaskArn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(instance.ReplicationTaskCreationDate)).
+				WithName(aws.StringValue(instance.ReplicationTaskIdentifier)))
 		}
 
 		return !lastPage
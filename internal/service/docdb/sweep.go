@@ -26,13 +26,17 @@ func init() {
 }
 
 func sweepGlobalClusters(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_docdb_global_cluster", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).DocDBConn
+	conn := client.(*conns.AWSClient).DocDBConn()
 	input := &docdb.DescribeGlobalClustersInput{}
 
 	err = conn.DescribeGlobalClustersPages(input, func(out *docdb.DescribeGlobalClustersOutput, lastPage bool) bool {
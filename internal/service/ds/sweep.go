@@ -31,13 +31,17 @@ func init() {
 }
 
 func sweepDirectories(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_directory_service_directory", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).DSConn
+	conn := client.(*conns.AWSClient).DSConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -199,7 +199,7 @@ func testAccClientVPNAuthorizationRule_disappears(t *testing.T) {
 }
 
 func testAccCheckClientVPNAuthorizationRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_client_vpn_authorization_rule" {
@@ -230,7 +230,7 @@ func testAccCheckClientVPNAuthorizationRuleExists(name string, assoc *ec2.Author
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		result, err := tfec2.FindClientVPNAuthorizationRuleByID(conn, rs.Primary.ID)
 		if err != nil {
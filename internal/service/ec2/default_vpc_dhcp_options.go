@@ -43,7 +43,7 @@ func ResourceDefaultVPCDHCPOptions() *schema.Resource {
 }
 
 func resourceDefaultVPCDHCPOptionsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	filters := []*ec2.Filter{
 		{
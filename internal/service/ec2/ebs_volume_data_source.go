@@ -78,7 +78,7 @@ func DataSourceEBSVolume() *schema.Resource {
 }
 
 func dataSourceEBSVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	filters, filtersOk := d.GetOk("filter")
 
@@ -83,7 +83,7 @@ func TestAccEC2EgressOnlyInternetGateway_tags(t *testing.T) {
 }
 
 func testAccCheckEgressOnlyInternetGatewayDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_egress_only_internet_gateway" {
@@ -118,7 +118,7 @@ func testAccCheckEgressOnlyInternetGatewayExists(n string, igw *ec2.EgressOnlyIn
 			return fmt.Errorf("No Egress Only IGW ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		resp, err := conn.DescribeEgressOnlyInternetGateways(&ec2.DescribeEgressOnlyInternetGatewaysInput{
 			EgressOnlyInternetGatewayIds: []*string{aws.String(rs.Primary.ID)},
 		})
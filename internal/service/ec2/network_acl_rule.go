@@ -124,7 +124,7 @@ func ResourceNetworkACLRule() *schema.Resource {
 }
 
 func resourceNetworkACLRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	egress := d.Get("egress").(bool)
 	networkAclID := d.Get("network_acl_id").(string)
 	ruleNumber := d.Get("rule_number").(int)
@@ -202,7 +202,7 @@ func resourceNetworkACLRuleCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	egress := d.Get("egress").(bool)
 	networkAclID := d.Get("network_acl_id").(string)
 	ruleNumber := d.Get("rule_number").(int)
@@ -286,7 +286,7 @@ func resourceNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceNetworkACLRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	params := &ec2.DeleteNetworkAclEntryInput{
 		NetworkAclId: aws.String(d.Get("network_acl_id").(string)),
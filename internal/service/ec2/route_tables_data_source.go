@@ -36,7 +36,7 @@ func DataSourceRouteTables() *schema.Resource {
 }
 
 func dataSourceRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DescribeRouteTablesInput{}
 
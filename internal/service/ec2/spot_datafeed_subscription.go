@@ -36,7 +36,7 @@ func ResourceSpotDataFeedSubscription() *schema.Resource {
 }
 
 func resourceSpotDataFeedSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	params := &ec2.CreateSpotDatafeedSubscriptionInput{
 		Bucket: aws.String(d.Get("bucket").(string)),
@@ -57,7 +57,7 @@ func resourceSpotDataFeedSubscriptionCreate(d *schema.ResourceData, meta interfa
 	return resourceSpotDataFeedSubscriptionRead(d, meta)
 }
 func resourceSpotDataFeedSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	resp, err := conn.DescribeSpotDatafeedSubscription(&ec2.DescribeSpotDatafeedSubscriptionInput{})
 	if err != nil {
@@ -83,7 +83,7 @@ func resourceSpotDataFeedSubscriptionRead(d *schema.ResourceData, meta interface
 	return nil
 }
 func resourceSpotDataFeedSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting Spot Datafeed Subscription")
 	_, err := conn.DeleteSpotDatafeedSubscription(&ec2.DeleteSpotDatafeedSubscriptionInput{})
@@ -255,6 +255,7 @@ func init() {
 			"aws_route_table",
 			"aws_security_group",
 			"aws_subnet",
+			"aws_vpc_endpoint",
 			"aws_vpc_peering_connection",
 			"aws_vpn_gateway",
 		},
@@ -276,11 +277,15 @@ func init() {
 }
 
 func sweepCapacityReservations(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ec2_capacity_reservation", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	resp, err := conn.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{})
 
@@ -320,11 +325,15 @@ func sweepCapacityReservations(region string) error {
 }
 
 func sweepCarrierGateway(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ec2_carrier_gateway", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeCarrierGatewaysInput{}
 	var sweeperErrs *multierror.Error
 
@@ -362,13 +371,17 @@ func sweepCarrierGateway(region string) error {
 }
 
 func sweepClientVPNEndpoints(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ec2_client_vpn_endpoint", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -404,13 +417,17 @@ func sweepClientVPNEndpoints(region string) error {
 }
 
 func sweepClientVPNNetworkAssociations(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ec2_client_vpn_network_association", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -471,11 +488,15 @@ func sweepClientVPNNetworkAssociations(region string) error {
 }
 
 func sweepEBSVolumes(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ebs_volume", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	err = conn.DescribeVolumesPages(&ec2.DescribeVolumesInput{}, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
 		for _, volume := range page.Volumes {
@@ -514,11 +535,15 @@ func sweepEBSVolumes(region string) error {
 }
 
 func sweepEgressOnlyInternetGateways(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_egress_only_internet_gateway", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeEgressOnlyInternetGatewaysInput{}
 	err = conn.DescribeEgressOnlyInternetGatewaysPages(input, func(page *ec2.DescribeEgressOnlyInternetGatewaysOutput, lastPage bool) bool {
@@ -563,7 +588,7 @@ func sweepEIPs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	// There is currently no paginator or Marker/NextToken
 	input := &ec2.DescribeAddressesInput{}
@@ -603,7 +628,8 @@ func sweepEIPs(region string) error {
 			d.SetId(aws.StringValue(address.PublicIp))
 		}
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+			WithTags(KeyValueTags(address.Tags).IgnoreAWS().Map()))
 	}
 
 	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
@@ -623,7 +649,7 @@ func sweepFlowLogs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeFlowLogsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -637,7 +663,9 @@ func sweepFlowLogs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(flowLog.FlowLogId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(flowLog.CreationTime)).
+				WithTags(KeyValueTags(flowLog.Tags).IgnoreAWS().Map()))
 		}
 
 		return !lastPage
@@ -666,7 +694,7 @@ func sweepHosts(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeHostsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -680,7 +708,9 @@ func sweepHosts(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(host.HostId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(host.AllocationTime)).
+				WithTags(KeyValueTags(host.Tags).IgnoreAWS().Map()))
 		}
 
 		return !lastPage
@@ -711,7 +741,7 @@ func sweepInstances(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -738,7 +768,12 @@ func sweepInstances(region string) error {
 				d.SetId(id)
 				d.Set("disable_api_termination", false)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				tags := KeyValueTags(instance.Tags).IgnoreAWS().Map()
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+					WithCreationTime(aws.TimeValue(instance.LaunchTime)).
+					WithName(tags["Name"]).
+					WithTags(tags))
 			}
 		}
 		return !lastPage
@@ -765,7 +800,7 @@ func sweepInternetGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	defaultVPCID := ""
 	describeVpcsInput := &ec2.DescribeVpcsInput{
@@ -818,7 +853,8 @@ func sweepInternetGateways(region string) error {
 				d.Set("vpc_id", internetGateway.Attachments[0].VpcId)
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithTags(KeyValueTags(internetGateway.Tags).IgnoreAWS().Map()))
 		}
 
 		return !lastPage
@@ -843,11 +879,15 @@ func sweepInternetGateways(region string) error {
 }
 
 func sweepKeyPairs(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_key_pair", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("Destroying the tmp keys in (%s)", client.(*conns.AWSClient).Region)
 
@@ -874,13 +914,17 @@ func sweepKeyPairs(region string) error {
 }
 
 func sweepLaunchTemplates(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_launch_template", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeLaunchTemplatesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -922,11 +966,15 @@ func sweepLaunchTemplates(region string) error {
 }
 
 func sweepNatGateways(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_nat_gateway", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DescribeNatGatewaysInput{}
 	resp, err := conn.DescribeNatGateways(req)
@@ -958,11 +1006,15 @@ func sweepNatGateways(region string) error {
 }
 
 func sweepNetworkACLs(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_network_acl", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DescribeNetworkAclsInput{}
 	resp, err := conn.DescribeNetworkAcls(req)
@@ -1040,11 +1092,15 @@ func sweepNetworkACLs(region string) error {
 }
 
 func sweepNetworkInterfaces(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_network_interface", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	err = conn.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
 		if page == nil {
@@ -1089,7 +1145,7 @@ func sweepPlacementGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribePlacementGroupsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -1109,7 +1165,9 @@ func sweepPlacementGroups(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(placementGroup.GroupName))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+			WithName(aws.StringValue(placementGroup.GroupName)).
+			WithTags(KeyValueTags(placementGroup.Tags).IgnoreAWS().Map()))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -1122,13 +1180,17 @@ func sweepPlacementGroups(region string) error {
 }
 
 func sweepRouteTables(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_route_table", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1241,11 +1303,15 @@ func sweepRouteTables(region string) error {
 }
 
 func sweepSecurityGroups(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_security_group", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeSecurityGroupsInput{}
 
@@ -1338,7 +1404,7 @@ func sweepSpotFleetRequests(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -1360,7 +1426,9 @@ func sweepSpotFleetRequests(region string) error {
 			d.SetId(id)
 			d.Set("terminate_instances_with_expiration", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(config.CreateTime)).
+				WithTags(KeyValueTags(config.Tags).IgnoreAWS().Map()))
 		}
 
 		return !lastPage
@@ -1389,7 +1457,7 @@ func sweepSubnets(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -1416,7 +1484,11 @@ func sweepSubnets(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			tags := KeyValueTags(subnet.Tags).IgnoreAWS().Map()
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithName(tags["Name"]).
+				WithTags(tags))
 		}
 
 		return !lastPage
@@ -1439,11 +1511,15 @@ func sweepSubnets(region string) error {
 }
 
 func sweepTransitGatewayPeeringAttachments(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ec2_transit_gateway_peering_attachment", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -1497,11 +1573,15 @@ func sweepTransitGatewayPeeringAttachments(region string) error {
 }
 
 func sweepTransitGateways(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ec2_transit_gateway", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeTransitGatewaysInput{}
 
 	for {
@@ -1578,11 +1658,15 @@ func sweepTransitGateways(region string) error {
 }
 
 func sweepTransitGatewayVPCAttachments(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ec2_transit_gateway_vpc_attachment", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeTransitGatewayAttachmentsInput{}
 
 	for {
@@ -1639,11 +1723,15 @@ func sweepTransitGatewayVPCAttachments(region string) error {
 }
 
 func sweepVPCDHCPOptions(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_vpc_dhcp_options", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeDhcpOptionsInput{}
 
@@ -1702,13 +1790,17 @@ func sweepVPCDHCPOptions(region string) error {
 }
 
 func sweepVPCEndpointServices(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_vpc_endpoint_service", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1762,13 +1854,17 @@ func sweepVPCEndpointServices(region string) error {
 }
 
 func sweepVPCEndpoints(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_vpc_endpoint", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1822,12 +1918,16 @@ func sweepVPCEndpoints(region string) error {
 }
 
 func sweepVPCPeeringConnections(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_vpc_peering_connection", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeVpcPeeringConnectionsInput{}
 
 	err = conn.DescribeVpcPeeringConnectionsPages(input, func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
@@ -1892,7 +1992,7 @@ func sweepVPCs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -1919,7 +2019,11 @@ func sweepVPCs(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			tags := KeyValueTags(vpc.Tags).IgnoreAWS().Map()
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithName(tags["Name"]).
+				WithTags(tags))
 		}
 
 		return !lastPage
@@ -1942,11 +2046,15 @@ func sweepVPCs(region string) error {
 }
 
 func sweepVPNConnections(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_vpn_connection", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeVpnConnectionsInput{}
 
 	// DescribeVpnConnections does not currently have any form of pagination
@@ -1992,11 +2100,15 @@ func sweepVPNConnections(region string) error {
 }
 
 func sweepVPNGateways(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_vpn_gateway", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	var sweeperErrs *multierror.Error
 
 	req := &ec2.DescribeVpnGatewaysInput{}
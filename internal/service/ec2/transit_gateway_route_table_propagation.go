@@ -47,7 +47,7 @@ func ResourceTransitGatewayRouteTablePropagation() *schema.Resource {
 }
 
 func resourceTransitGatewayRouteTablePropagationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayAttachmentID := d.Get("transit_gateway_attachment_id").(string)
 	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
@@ -72,7 +72,7 @@ func resourceTransitGatewayRouteTablePropagationCreate(d *schema.ResourceData, m
 }
 
 func resourceTransitGatewayRouteTablePropagationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayRouteTableID, transitGatewayAttachmentID, err := DecodeTransitGatewayRouteTablePropagationID(d.Id())
 	if err != nil {
@@ -110,7 +110,7 @@ func resourceTransitGatewayRouteTablePropagationRead(d *schema.ResourceData, met
 }
 
 func resourceTransitGatewayRouteTablePropagationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayRouteTableID, transitGatewayAttachmentID, err := DecodeTransitGatewayRouteTablePropagationID(d.Id())
 	if err != nil {
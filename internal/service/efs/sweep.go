@@ -37,11 +37,15 @@ func init() {
 }
 
 func sweepAccessPoints(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_efs_access_point", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EFSConn
+	conn := client.(*conns.AWSClient).EFSConn()
 	var sweeperErrs *multierror.Error
 
 	var errors error
@@ -98,11 +102,15 @@ func sweepAccessPoints(region string) error {
 }
 
 func sweepFileSystems(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_efs_file_system", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EFSConn
+	conn := client.(*conns.AWSClient).EFSConn()
 	var sweeperErrs *multierror.Error
 
 	input := &efs.DescribeFileSystemsInput{}
@@ -133,11 +141,15 @@ func sweepFileSystems(region string) error {
 }
 
 func sweepMountTargets(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_efs_mount_target", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EFSConn
+	conn := client.(*conns.AWSClient).EFSConn()
 
 	var errors error
 	input := &efs.DescribeFileSystemsInput{}
@@ -35,11 +35,15 @@ func init() {
 }
 
 func sweepLoadBalancers(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_lb", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ELBV2Conn
+	conn := client.(*conns.AWSClient).ELBV2Conn()
 
 	var sweeperErrs *multierror.Error
 	err = conn.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
@@ -74,11 +78,15 @@ func sweepLoadBalancers(region string) error {
 }
 
 func sweepTargetGroups(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_lb_target_group", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ELBV2Conn
+	conn := client.(*conns.AWSClient).ELBV2Conn()
 
 	err = conn.DescribeTargetGroupsPages(&elbv2.DescribeTargetGroupsInput{}, func(page *elbv2.DescribeTargetGroupsOutput, lastPage bool) bool {
 		if page == nil || len(page.TargetGroups) == 0 {
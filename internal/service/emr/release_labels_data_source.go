@@ -44,7 +44,7 @@ func DataSourceReleaseLabels() *schema.Resource {
 }
 
 func dataSourceReleaseLabelsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).EMRConn
+	conn := meta.(*conns.AWSClient).EMRConn()
 
 	input := &emr.ListReleaseLabelsInput{}
 
@@ -52,7 +52,7 @@ func dataSourceReleaseLabelsRead(ctx context.Context, d *schema.ResourceData, me
 		input.Filters = expandReleaseLabelsFilters(v.([]interface{}))
 	}
 
-	out, err := conn.ListReleaseLabels(input)
+	out, err := conn.ListReleaseLabelsWithContext(ctx, input)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error reading EMR Release Label: %w", err))
 	}
@@ -22,11 +22,15 @@ func init() {
 }
 
 func sweepClusters(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_emr_cluster", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EMRConn
+	conn := client.(*conns.AWSClient).EMRConn()
 
 	input := &emr.ListClustersInput{
 		ClusterStates: []*string{
@@ -68,11 +68,15 @@ func init() {
 }
 
 func sweepAPIDestination(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudwatch_event_api_destination", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EventsConn
+	conn := client.(*conns.AWSClient).EventsConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -118,11 +122,15 @@ func sweepAPIDestination(region string) error {
 }
 
 func sweepArchives(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudwatch_event_archive", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EventsConn
+	conn := client.(*conns.AWSClient).EventsConn()
 
 	input := &eventbridge.ListArchivesInput{}
 
@@ -168,11 +176,15 @@ func sweepArchives(region string) error {
 }
 
 func sweepBuses(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudwatch_event_bus", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EventsConn
+	conn := client.(*conns.AWSClient).EventsConn()
 	input := &eventbridge.ListEventBusesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -215,11 +227,15 @@ func sweepBuses(region string) error {
 }
 
 func sweepConnection(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudwatch_event_connection", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EventsConn
+	conn := client.(*conns.AWSClient).EventsConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -262,11 +278,15 @@ func sweepConnection(region string) error {
 }
 
 func sweepPermissions(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudwatch_event_permission", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EventsConn
+	conn := client.(*conns.AWSClient).EventsConn()
 
 	output, err := conn.DescribeEventBus(&eventbridge.DescribeEventBusInput{})
 	if err != nil {
@@ -306,11 +326,15 @@ func sweepPermissions(region string) error {
 }
 
 func sweepRules(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudwatch_event_rule", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EventsConn
+	conn := client.(*conns.AWSClient).EventsConn()
 
 	var sweeperErrs *multierror.Error
 	var count int
@@ -355,11 +379,15 @@ func sweepRules(region string) error {
 }
 
 func sweepTargets(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_cloudwatch_event_target", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EventsConn
+	conn := client.(*conns.AWSClient).EventsConn()
 
 	var sweeperErrs *multierror.Error
 	var rulesCount, targetsCount int
@@ -45,11 +45,15 @@ func init() {
 }
 
 func sweepAliases(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_gamelift_alias", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GameLiftConn
+	conn := client.(*conns.AWSClient).GameLiftConn()
 
 	err = listAliases(&gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
 		if len(resp.Aliases) == 0 {
@@ -83,11 +87,15 @@ func sweepAliases(region string) error {
 }
 
 func sweepBuilds(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_gamelift_build", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GameLiftConn
+	conn := client.(*conns.AWSClient).GameLiftConn()
 
 	resp, err := conn.ListBuilds(&gamelift.ListBuildsInput{})
 	if err != nil {
@@ -120,11 +128,15 @@ func sweepBuilds(region string) error {
 }
 
 func sweepFleets(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_gamelift_fleet", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GameLiftConn
+	conn := client.(*conns.AWSClient).GameLiftConn()
 
 	return listFleets(conn, nil, region, func(fleetIds []*string) error {
 		if len(fleetIds) == 0 {
@@ -172,11 +184,15 @@ func sweepFleets(region string) error {
 }
 
 func sweepGameSessionQueue(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_gamelift_game_session_queue", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GameLiftConn
+	conn := client.(*conns.AWSClient).GameLiftConn()
 
 	out, err := conn.DescribeGameSessionQueues(&gamelift.DescribeGameSessionQueuesInput{})
 
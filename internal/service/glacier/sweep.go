@@ -23,11 +23,15 @@ func init() {
 }
 
 func sweepVaults(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_glacier_vault", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).GlacierConn
+	conn := client.(*conns.AWSClient).GlacierConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListVaultsPages(&glacier.ListVaultsInput{}, func(page *glacier.ListVaultsOutput, lastPage bool) bool {
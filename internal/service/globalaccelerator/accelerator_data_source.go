@@ -85,7 +85,7 @@ func DataSourceAccelerator() *schema.Resource {
 }
 
 func dataSourceAcceleratorRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	var results []*globalaccelerator.Accelerator
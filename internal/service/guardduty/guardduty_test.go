@@ -34,6 +34,11 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"basic":  testAccOrganizationConfiguration_basic,
 			"s3Logs": testAccOrganizationConfiguration_s3logs,
 		},
+		"Tag": {
+			"basic":      testAccTag_basic,
+			"disappears": testAccTag_disappears,
+			"value":      testAccTag_value,
+		},
 		"ThreatIntelSet": {
 			"basic": testAccThreatintelset_basic,
 			"tags":  testAccThreatintelset_tags,
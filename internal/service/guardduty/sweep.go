@@ -30,13 +30,17 @@ func init() {
 }
 
 func sweepDetectors(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_guardduty_detector", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).GuardDutyConn
+	conn := client.(*conns.AWSClient).GuardDutyConn()
 	input := &guardduty.ListDetectorsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -76,13 +80,17 @@ func sweepDetectors(region string) error {
 }
 
 func sweepPublishingDestinations(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_guardduty_publishing_destination", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).GuardDutyConn
+	conn := client.(*conns.AWSClient).GuardDutyConn()
 	var sweeperErrs *multierror.Error
 
 	detect_input := &guardduty.ListDetectorsInput{}
@@ -98,13 +98,17 @@ func init() {
 }
 
 func sweepGroups(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_iam_group", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 	input := &iam.ListGroupsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -213,11 +217,15 @@ func sweepGroups(region string) error {
 }
 
 func sweepInstanceProfile(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_iam_instance_profile", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -270,11 +278,15 @@ func sweepInstanceProfile(region string) error {
 }
 
 func sweepOpenIDConnectProvider(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_iam_openid_connect_provider", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -309,13 +321,17 @@ func sweepOpenIDConnectProvider(region string) error {
 }
 
 func sweepPolicies(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_iam_policy", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 	input := &iam.ListPoliciesInput{
 		Scope: aws.String(iam.PolicyScopeTypeLocal),
 	}
@@ -377,11 +393,15 @@ func sweepPolicies(region string) error {
 }
 
 func sweepRoles(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_iam_role", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	roles := make([]string, 0)
 	err = conn.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
@@ -436,11 +456,15 @@ func sweepRoles(region string) error {
 }
 
 func sweepSamlProvider(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_iam_saml_provider", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -475,11 +499,15 @@ func sweepSamlProvider(region string) error {
 }
 
 func sweepServerCertificates(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_iam_server_certificate", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	err = conn.ListServerCertificatesPages(&iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
 		for _, sc := range out.ServerCertificateMetadataList {
@@ -508,11 +536,15 @@ func sweepServerCertificates(region string) error {
 }
 
 func sweepServiceLinkedRoles(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_iam_service_linked_role", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String("/aws-service-role/"),
@@ -565,11 +597,15 @@ func sweepServiceLinkedRoles(region string) error {
 }
 
 func sweepUsers(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_iam_user", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 	prefixes := []string{
 		"test-user",
 		"test_user",
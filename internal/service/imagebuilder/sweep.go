@@ -48,11 +48,15 @@ func init() {
 }
 
 func sweepComponents(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_imagebuilder_component", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -128,11 +132,15 @@ func sweepComponents(region string) error {
 }
 
 func sweepDistributionConfigurations(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_imagebuilder_distribution_configuration", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -180,11 +188,15 @@ func sweepDistributionConfigurations(region string) error {
 }
 
 func sweepImagePipelines(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_imagebuilder_image_pipeline", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -232,11 +244,15 @@ func sweepImagePipelines(region string) error {
 }
 
 func sweepImageRecipes(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_imagebuilder_image_recipe", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -292,7 +308,7 @@ func sweepImages(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -335,7 +351,9 @@ func sweepImages(region string) error {
 					d := r.Data(nil)
 					d.SetId(imageBuildVersionArn)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+						WithName(aws.StringValue(imageSummary.Name)).
+						WithTags(aws.StringValueMap(imageSummary.Tags)))
 				}
 
 				return !lastPage
@@ -366,11 +384,15 @@ func sweepImages(region string) error {
 }
 
 func sweepInfrastructureConfigurations(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_imagebuilder_infrastructure_configuration", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
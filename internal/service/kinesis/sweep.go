@@ -23,11 +23,15 @@ func init() {
 }
 
 func sweepStreams(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_kinesis_stream", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).KinesisConn
+	conn := client.(*conns.AWSClient).KinesisConn()
 	input := &kinesis.ListStreamsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -25,11 +25,15 @@ func init() {
 }
 
 func sweepApplications(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_kinesis_analytics_application", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).KinesisAnalyticsConn
+	conn := client.(*conns.AWSClient).KinesisAnalyticsConn()
 	input := &kinesisanalytics.ListApplicationsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -24,11 +24,15 @@ func init() {
 }
 
 func sweepApplication(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_kinesisanalyticsv2_application", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).KinesisAnalyticsV2Conn
+	conn := client.(*conns.AWSClient).KinesisAnalyticsV2Conn()
 	input := &kinesisanalyticsv2.ListApplicationsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOp=ListTags -ListTagsInIDElem=Resource -ServiceTagsMap -TagInIDElem=Resource -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ListTagsOp=ListTags -ListTagsInIDElem=Resource -ParentNotFoundErrCode=ResourceNotFoundException -ServiceTagsMap -TagInIDElem=Resource -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package lambda
@@ -131,7 +131,7 @@ func ResourceLayerVersion() *schema.Resource {
 }
 
 func resourceLayerVersionPublish(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	layerName := d.Get("layer_name").(string)
 	filename, hasFilename := d.GetOk("filename")
@@ -193,7 +193,7 @@ func resourceLayerVersionPublish(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceLayerVersionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	layerName, version, err := LayerVersionParseID(d.Id())
 	if err != nil {
@@ -260,7 +260,7 @@ func resourceLayerVersionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLayerVersionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	version, err := strconv.ParseInt(d.Get("version").(string), 10, 64)
 	if err != nil {
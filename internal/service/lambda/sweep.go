@@ -26,12 +26,16 @@ func init() {
 }
 
 func sweepFunctions(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_lambda_function", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).LambdaConn
+	conn := client.(*conns.AWSClient).LambdaConn()
 
 	resp, err := conn.ListFunctions(&lambda.ListFunctionsInput{})
 	if err != nil {
@@ -61,12 +65,16 @@ func sweepFunctions(region string) error {
 }
 
 func sweepLayerVersions(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_lambda_layer", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).LambdaConn
+	conn := client.(*conns.AWSClient).LambdaConn()
 	resp, err := conn.ListLayers(&lambda.ListLayersInput{})
 	if err != nil {
 		if sweep.SkipSweepError(err) {
@@ -47,7 +47,7 @@ func sweepBotAliases(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).LexModelsConn
+	conn := client.(*conns.AWSClient).LexModelsConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -76,7 +76,9 @@ func sweepBotAliases(region string) error {
 					d.Set("bot_name", bot.Name)
 					d.Set("name", botAlias.Name)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+						WithCreationTime(aws.TimeValue(botAlias.CreatedDate)).
+						WithName(aws.StringValue(botAlias.Name)))
 				}
 
 				return !lastPage
@@ -91,7 +93,9 @@ func sweepBotAliases(region string) error {
 
 			d.SetId(aws.StringValue(bot.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(bot.CreatedDate)).
+				WithName(aws.StringValue(bot.Name)))
 		}
 
 		return !lastPage
@@ -120,7 +124,7 @@ func sweepBots(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).LexModelsConn
+	conn := client.(*conns.AWSClient).LexModelsConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -137,7 +141,9 @@ func sweepBots(region string) error {
 
 			d.SetId(aws.StringValue(bot.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(bot.CreatedDate)).
+				WithName(aws.StringValue(bot.Name)))
 		}
 
 		return !lastPage
@@ -166,7 +172,7 @@ func sweepIntents(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).LexModelsConn
+	conn := client.(*conns.AWSClient).LexModelsConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -183,7 +189,9 @@ func sweepIntents(region string) error {
 
 			d.SetId(aws.StringValue(intent.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(intent.CreatedDate)).
+				WithName(aws.StringValue(intent.Name)))
 		}
 
 		return !lastPage
@@ -212,7 +220,7 @@ func sweepSlotTypes(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).LexModelsConn
+	conn := client.(*conns.AWSClient).LexModelsConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -229,7 +237,9 @@ func sweepSlotTypes(region string) error {
 
 			d.SetId(aws.StringValue(slotType.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithCreationTime(aws.TimeValue(slotType.CreatedDate)).
+				WithName(aws.StringValue(slotType.Name)))
 		}
 
 		return !lastPage
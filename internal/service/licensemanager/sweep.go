@@ -22,11 +22,15 @@ func init() {
 }
 
 func sweepLicenseConfigurations(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_licensemanager_license_configuration", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).LicenseManagerConn
+	conn := client.(*conns.AWSClient).LicenseManagerConn()
 
 	resp, err := conn.ListLicenseConfigurations(&licensemanager.ListLicenseConfigurationsInput{})
 
@@ -28,11 +28,15 @@ func init() {
 }
 
 func sweepInstances(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_lightsail_instance", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).LightsailConn
+	conn := client.(*conns.AWSClient).LightsailConn()
 
 	input := &lightsail.GetInstancesInput{}
 	var sweeperErrs *multierror.Error
@@ -76,11 +80,15 @@ func sweepInstances(region string) error {
 }
 
 func sweepStaticIPs(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_lightsail_static_ip", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).LightsailConn
+	conn := client.(*conns.AWSClient).LightsailConn()
 
 	input := &lightsail.GetStaticIpsInput{}
 
@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=Resource -ServiceTagsSlice -TagInIDElem=Resource -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go -TFResourceType=aws_media_store_tag
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ListTagsInIDElem=Resource -ServiceTagsSlice -TagInIDElem=Resource -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package mediastore
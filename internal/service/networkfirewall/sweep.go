@@ -47,11 +47,15 @@ func init() {
 }
 
 func sweepFirewallPolicies(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_networkfirewall_firewall_policy", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	ctx := context.Background()
 	input := &networkfirewall.ListFirewallPoliciesInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -97,11 +101,15 @@ func sweepFirewallPolicies(region string) error {
 }
 
 func sweepFirewalls(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_networkfirewall_firewall", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	ctx := context.TODO()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -148,11 +156,15 @@ func sweepFirewalls(region string) error {
 }
 
 func sweepLoggingConfigurations(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_networkfirewall_logging_configuration", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	ctx := context.TODO()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -199,11 +211,15 @@ func sweepLoggingConfigurations(region string) error {
 }
 
 func sweepRuleGroups(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_networkfirewall_rule_group", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).NetworkFirewallConn
+	conn := client.(*conns.AWSClient).NetworkFirewallConn()
 	ctx := context.Background()
 	input := &networkfirewall.ListRuleGroupsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -50,6 +50,11 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"basic":      testAccDelegatedAdministrator_basic,
 			"disappears": testAccDelegatedAdministrator_disappears,
 		},
+		"Tag": {
+			"basic":      testAccTag_basic,
+			"disappears": testAccTag_disappears,
+			"value":      testAccTag_value,
+		},
 	}
 
 	for group, m := range testCases {
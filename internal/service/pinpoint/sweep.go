@@ -22,11 +22,15 @@ func init() {
 }
 
 func sweepApps(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_pinpoint_app", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).PinpointConn
+	conn := client.(*conns.AWSClient).PinpointConn()
 
 	input := &pinpoint.GetAppsInput{}
 
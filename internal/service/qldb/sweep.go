@@ -22,13 +22,17 @@ func init() {
 }
 
 func sweepLedgers(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_qldb_ledger", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).QLDBConn
+	conn := client.(*conns.AWSClient).QLDBConn()
 	input := &qldb.ListLedgersInput{}
 	page, err := conn.ListLedgers(input)
 
@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceName -ListTagsOutTagsElem=TagList -ServiceTagsSlice -TagOp=AddTagsToResource -TagInIDElem=ResourceName -UntagOp=RemoveTagsFromResource -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ListTagsInIDElem=ResourceName -ListTagsOutTagsElem=TagList -ParentNotFoundErrCode=DBClusterEndpointNotFoundFault,DBClusterNotFoundFault,DBClusterParameterGroupNotFound,DBClusterSnapshotNotFoundFault,DBInstanceNotFound,DBParameterGroupNotFound,DBProxyEndpointNotFoundFault,DBProxyNotFoundFault,DBProxyTargetGroupNotFoundFault,DBSecurityGroupNotFound,DBSnapshotNotFound,DBSubnetGroupNotFoundFault,OptionGroupNotFoundFault,ReservedDBInstanceNotFound,SubscriptionNotFound -ServiceTagsSlice -TagOp=AddTagsToResource -TagInIDElem=ResourceName -UntagOp=RemoveTagsFromResource -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package rds
@@ -6,9 +6,31 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// GetTag fetches an individual rds service tag for a resource.
+// Returns whether the key value and any errors. A NotFoundError is used to signal that no value was found.
+// This function will optimise the handling over ListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func GetTag(conn *rds.RDS, identifier string, key string) (*string, error) {
+	listTags, err := ListTags(conn, identifier)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !listTags.KeyExists(key) {
+		return nil, tfresource.NewEmptyResultError(nil)
+	}
+
+	return listTags.KeyValue(key), nil
+}
+
 // ListTags lists rds service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
@@ -19,6 +41,13 @@ func ListTags(conn *rds.RDS, identifier string) (tftags.KeyValueTags, error) {
 
 	output, err := conn.ListTagsForResource(input)
 
+	if tfawserr.ErrCodeEquals(err, "DBClusterEndpointNotFoundFault", "DBClusterNotFoundFault", "DBClusterParameterGroupNotFound", "DBClusterSnapshotNotFoundFault", "DBInstanceNotFound", "DBParameterGroupNotFound", "DBProxyEndpointNotFoundFault", "DBProxyNotFoundFault", "DBProxyTargetGroupNotFoundFault", "DBSecurityGroupNotFound", "DBSnapshotNotFound", "DBSubnetGroupNotFoundFault", "OptionGroupNotFoundFault", "ReservedDBInstanceNotFound", "SubscriptionNotFound") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
 	if err != nil {
 		return tftags.New(nil), err
 	}
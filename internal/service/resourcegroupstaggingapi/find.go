@@ -0,0 +1,107 @@
+package resourcegroupstaggingapi
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// findResourceTagMappings returns the tagged resources in each region's service
+// client, querying the regions concurrently. Resources returned by more than
+// one region, such as global resources, are only returned once.
+func findResourceTagMappings(regionConns map[string]*resourcegroupstaggingapi.ResourceGroupsTaggingAPI, input *resourcegroupstaggingapi.GetResourcesInput) ([]*resourcegroupstaggingapi.ResourceTagMapping, error) {
+	var g multierror.Group
+	var lock sync.Mutex
+	regionTaggings := make(map[string][]*resourcegroupstaggingapi.ResourceTagMapping, len(regionConns))
+
+	for region, conn := range regionConns {
+		region, conn := region, conn
+
+		g.Go(func() error {
+			// Each request pages through its own copy of the input.
+			input := *input
+
+			var taggings []*resourcegroupstaggingapi.ResourceTagMapping
+
+			err := conn.GetResourcesPages(&input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				taggings = append(taggings, page.ResourceTagMappingList...)
+				return !lastPage
+			})
+
+			if err != nil {
+				return fmt.Errorf("region %s: %w", region, err)
+			}
+
+			lock.Lock()
+			regionTaggings[region] = taggings
+			lock.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := g.Wait().ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	regions := make([]string, 0, len(regionTaggings))
+	for region := range regionTaggings {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	var result []*resourcegroupstaggingapi.ResourceTagMapping
+	seen := map[string]bool{}
+
+	for _, region := range regions {
+		for _, tagging := range regionTaggings[region] {
+			if tagging == nil {
+				continue
+			}
+
+			resourceARN := aws.StringValue(tagging.ResourceARN)
+
+			if seen[resourceARN] {
+				continue
+			}
+
+			seen[resourceARN] = true
+			result = append(result, tagging)
+		}
+	}
+
+	return result, nil
+}
+
+// findRegionNames returns the names of the regions that are enabled for the
+// account, sorted by name.
+func findRegionNames(conn *ec2.EC2) ([]string, error) {
+	output, err := conn.DescribeRegions(&ec2.DescribeRegionsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var regions []string
+
+	for _, region := range output.Regions {
+		if region == nil {
+			continue
+		}
+
+		regions = append(regions, aws.StringValue(region.RegionName))
+	}
+
+	sort.Strings(regions)
+
+	return regions, nil
+}
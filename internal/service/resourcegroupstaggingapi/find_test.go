@@ -0,0 +1,237 @@
+package resourcegroupstaggingapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+)
+
+// testTaggingServer is a Resource Groups Tagging API stand-in that returns the
+// resources of the region that a request is signed for.
+type testTaggingServer struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	requests map[string]int
+
+	// pages contains the pages of resource ARNs returned for each region.
+	pages map[string][][]string
+}
+
+var testCredentialRegionRegexp = regexp.MustCompile(`Credential=[^/]+/[^/]+/([^/]+)/`)
+
+func newTestTaggingServer(pages map[string][][]string) *testTaggingServer {
+	s := &testTaggingServer{
+		pages:    pages,
+		requests: map[string]int{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+func (s *testTaggingServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+	var region string
+	if m := testCredentialRegionRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		region = m[1]
+	}
+
+	s.lock.Lock()
+	s.requests[region]++
+	s.lock.Unlock()
+
+	pages, ok := s.pages[region]
+
+	if !ok || !strings.HasSuffix(r.Header.Get("X-Amz-Target"), ".GetResources") {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"__type":"InvalidParameterException","message":"unexpected request in region %s"}`, region)
+		return
+	}
+
+	var input struct {
+		PaginationToken string
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"__type":"InvalidParameterException","message":%q}`, err)
+		return
+	}
+
+	var page int
+	if input.PaginationToken != "" {
+		fmt.Sscanf(input.PaginationToken, "page%d", &page) //nolint:errcheck
+	}
+
+	output := map[string]interface{}{}
+	var mappings []map[string]interface{}
+
+	for _, resourceARN := range pages[page] {
+		mappings = append(mappings, map[string]interface{}{
+			"ResourceARN": resourceARN,
+			"Tags":        []map[string]string{{"Key": "team", "Value": "example"}},
+		})
+	}
+
+	output["ResourceTagMappingList"] = mappings
+
+	if page+1 < len(pages) {
+		output["PaginationToken"] = fmt.Sprintf("page%d", page+1)
+	}
+
+	json.NewEncoder(w).Encode(output) //nolint:errcheck
+}
+
+func testRegionConns(t *testing.T, endpoint string, regions ...string) map[string]*resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	t.Helper()
+
+	regionConns := make(map[string]*resourcegroupstaggingapi.ResourceGroupsTaggingAPI, len(regions))
+
+	for _, region := range regions {
+		sess, err := session.NewSession(&aws.Config{
+			Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+			Endpoint:    aws.String(endpoint),
+			MaxRetries:  aws.Int(0),
+			Region:      aws.String(region),
+		})
+
+		if err != nil {
+			t.Fatalf("error creating session: %s", err)
+		}
+
+		regionConns[region] = resourcegroupstaggingapi.New(sess)
+	}
+
+	return regionConns
+}
+
+func TestFindResourceTagMappings(t *testing.T) {
+	server := newTestTaggingServer(map[string][][]string{
+		"us-east-1": { //lintignore:AWSAT003
+			{
+				"arn:aws:ec2:us-east-1:123456789012:vpc/vpc-11111111", //lintignore:AWSAT003,AWSAT005
+				"arn:aws:iam::123456789012:role/example",              //lintignore:AWSAT005
+			},
+			{
+				"arn:aws:ec2:us-east-1:123456789012:vpc/vpc-22222222", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		"eu-west-1": { //lintignore:AWSAT003
+			{
+				"arn:aws:iam::123456789012:role/example",              //lintignore:AWSAT005
+				"arn:aws:ec2:eu-west-1:123456789012:vpc/vpc-33333333", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+	})
+	defer server.Close()
+
+	regionConns := testRegionConns(t, server.URL, "us-east-1", "eu-west-1") //lintignore:AWSAT003
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: []*resourcegroupstaggingapi.TagFilter{
+			{
+				Key:    aws.String("team"),
+				Values: aws.StringSlice([]string{"example"}),
+			},
+		},
+	}
+
+	output, err := findResourceTagMappings(regionConns, input)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, v := range output {
+		got = append(got, aws.StringValue(v.ResourceARN))
+	}
+
+	// Regions are merged in name order and duplicate resources are removed.
+	expected := []string{
+		"arn:aws:iam::123456789012:role/example",              //lintignore:AWSAT005
+		"arn:aws:ec2:eu-west-1:123456789012:vpc/vpc-33333333", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ec2:us-east-1:123456789012:vpc/vpc-11111111", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ec2:us-east-1:123456789012:vpc/vpc-22222222", //lintignore:AWSAT003,AWSAT005
+	}
+
+	if strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	if got, expected := server.requests["us-east-1"], 2; got != expected { //lintignore:AWSAT003
+		t.Errorf("got %d us-east-1 requests, expected %d", got, expected)
+	}
+
+	if got, expected := server.requests["eu-west-1"], 1; got != expected { //lintignore:AWSAT003
+		t.Errorf("got %d eu-west-1 requests, expected %d", got, expected)
+	}
+
+	if input.PaginationToken != nil {
+		t.Error("expected input not to be modified")
+	}
+}
+
+func TestFindResourceTagMappingsError(t *testing.T) {
+	server := newTestTaggingServer(map[string][][]string{
+		"us-east-1": {{"arn:aws:ec2:us-east-1:123456789012:vpc/vpc-11111111"}}, //lintignore:AWSAT003,AWSAT005
+	})
+	defer server.Close()
+
+	regionConns := testRegionConns(t, server.URL, "us-east-1", "ap-southeast-2") //lintignore:AWSAT003
+
+	_, err := findResourceTagMappings(regionConns, &resourcegroupstaggingapi.GetResourcesInput{})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if !strings.Contains(err.Error(), "region ap-southeast-2") { //lintignore:AWSAT003
+		t.Errorf("expected error to name the failed region, got: %s", err)
+	}
+}
+
+func TestFindRegionNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, `<DescribeRegionsResponse><regionInfo>`+
+			`<item><regionName>us-west-2</regionName></item>`+ //lintignore:AWSAT003
+			`<item><regionName>eu-west-1</regionName></item>`+ //lintignore:AWSAT003
+			`</regionInfo></DescribeRegionsResponse>`)
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	got, err := findRegionNames(ec2.New(sess))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"eu-west-1", "us-west-2"}; strings.Join(got, ",") != strings.Join(expected, ",") { //lintignore:AWSAT003
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
@@ -0,0 +1,36 @@
+package resourcegroupstaggingapi
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// flattenResourceARN returns the region, service, account ID and resource type
+// of a resource ARN. The resource type is the part of the ARN's resource before
+// the first "/" or ":" separator and is empty for resources without one, such
+// as S3 buckets. Global resources have no region.
+func flattenResourceARN(s string) map[string]interface{} {
+	m := map[string]interface{}{
+		"account_id":    "",
+		"region":        "",
+		"resource_type": "",
+		"service":       "",
+	}
+
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return m
+	}
+
+	m["account_id"] = parsedARN.AccountID
+	m["region"] = parsedARN.Region
+	m["service"] = parsedARN.Service
+
+	if i := strings.IndexAny(parsedARN.Resource, "/:"); i > 0 {
+		m["resource_type"] = parsedARN.Resource[:i]
+	}
+
+	return m
+}
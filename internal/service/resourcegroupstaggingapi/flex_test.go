@@ -0,0 +1,85 @@
+package resourcegroupstaggingapi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenResourceARN(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		ARN      string
+		Expected map[string]interface{}
+	}{
+		{
+			Name: "slash separated resource",
+			ARN:  "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]interface{}{
+				"account_id":    "123456789012",
+				"region":        "us-west-2", //lintignore:AWSAT003
+				"resource_type": "vpc",
+				"service":       "ec2",
+			},
+		},
+		{
+			Name: "colon separated resource",
+			ARN:  "arn:aws:lambda:eu-west-1:123456789012:function:example", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]interface{}{
+				"account_id":    "123456789012",
+				"region":        "eu-west-1", //lintignore:AWSAT003
+				"resource_type": "function",
+				"service":       "lambda",
+			},
+		},
+		{
+			Name: "global resource",
+			ARN:  "arn:aws:iam::123456789012:role/path/example", //lintignore:AWSAT005
+			Expected: map[string]interface{}{
+				"account_id":    "123456789012",
+				"region":        "",
+				"resource_type": "role",
+				"service":       "iam",
+			},
+		},
+		{
+			Name: "resource without type",
+			ARN:  "arn:aws:s3:::example-bucket", //lintignore:AWSAT005
+			Expected: map[string]interface{}{
+				"account_id":    "",
+				"region":        "",
+				"resource_type": "",
+				"service":       "s3",
+			},
+		},
+		{
+			Name: "other partition",
+			ARN:  "arn:aws-us-gov:sns:us-gov-west-1:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]interface{}{
+				"account_id":    "123456789012",
+				"region":        "us-gov-west-1", //lintignore:AWSAT003
+				"resource_type": "",
+				"service":       "sns",
+			},
+		},
+		{
+			Name: "invalid ARN",
+			ARN:  "example",
+			Expected: map[string]interface{}{
+				"account_id":    "",
+				"region":        "",
+				"resource_type": "",
+				"service":       "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenResourceARN(testCase.ARN)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func DataSourceResources() *schema.Resource {
@@ -16,6 +17,11 @@ func DataSourceResources() *schema.Resource {
 		Read: dataSourceResourcesRead,
 
 		Schema: map[string]*schema.Schema{
+			"all_regions": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"regions"},
+			},
 			"exclude_compliant_resources": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -24,6 +30,15 @@ func DataSourceResources() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidRegionName,
+				},
+				ConflictsWith: []string{"all_regions"},
+			},
 			"resource_arn_list": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -61,10 +76,26 @@ func DataSourceResources() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"resource_arn": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"compliance_details": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -96,7 +127,7 @@ func DataSourceResources() *schema.Resource {
 }
 
 func dataSourceResourcesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn
+	client := meta.(*conns.AWSClient)
 
 	input := &resourcegroupstaggingapi.GetResourcesInput{}
 
@@ -120,21 +151,33 @@ func dataSourceResourcesRead(d *schema.ResourceData, meta interface{}) error {
 		input.ResourceTypeFilters = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
-	var taggings []*resourcegroupstaggingapi.ResourceTagMapping
+	regionConns := map[string]*resourcegroupstaggingapi.ResourceGroupsTaggingAPI{}
+
+	if d.Get("all_regions").(bool) {
+		regions, err := findRegionNames(client.EC2Conn())
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Regions: %w", err)
+		}
 
-	err := conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+		for _, region := range regions {
+			regionConns[region] = client.ResourceGroupsTaggingAPIConnForRegion(region)
+		}
+	} else if v, ok := d.GetOk("regions"); ok && v.(*schema.Set).Len() > 0 {
+		for _, region := range v.(*schema.Set).List() {
+			regionConns[region.(string)] = client.ResourceGroupsTaggingAPIConnForRegion(region.(string))
 		}
+	} else {
+		regionConns[client.Region] = client.ResourceGroupsTaggingAPIConn()
+	}
+
+	taggings, err := findResourceTagMappings(regionConns, input)
 
-		taggings = append(taggings, page.ResourceTagMappingList...)
-		return !lastPage
-	})
 	if err != nil {
 		return fmt.Errorf("error getting Resource Groups Tags API Resources: %w", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Partition)
+	d.SetId(client.Partition)
 
 	if err := d.Set("resource_tag_mapping_list", flattenResourcesTagMappingList(taggings)); err != nil {
 		return fmt.Errorf("error setting resource tag mapping list: %w", err)
@@ -170,6 +213,10 @@ func flattenResourcesTagMappingList(list []*resourcegroupstaggingapi.ResourceTag
 			"tags":         KeyValueTags(i.Tags).Map(),
 		}
 
+		for k, v := range flattenResourceARN(aws.StringValue(i.ResourceARN)) {
+			l[k] = v
+		}
+
 		if i.ComplianceDetails != nil {
 			l["compliance_details"] = flattenComplianceDetails(i.ComplianceDetails)
 		}
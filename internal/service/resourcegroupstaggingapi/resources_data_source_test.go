@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
@@ -99,6 +100,44 @@ func TestAccResourceGroupsTaggingAPIResourcesDataSource_resourceARNList(t *testi
 	})
 }
 
+func TestAccResourceGroupsTaggingAPIResourcesDataSource_regions(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_resourcegroupstaggingapi_resources.test"
+	resourceName := "aws_vpc.test"
+	alternateResourceName := "aws_vpc.alternate"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesRegionsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resource_tag_mapping_list.*.resource_arn", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resource_tag_mapping_list.*.resource_arn", alternateResourceName, "arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_tag_mapping_list.*", map[string]string{
+						"region":        acctest.Region(),
+						"resource_type": "vpc",
+						"service":       "ec2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_tag_mapping_list.*", map[string]string{
+						"region":        acctest.AlternateRegion(),
+						"resource_type": "vpc",
+						"service":       "ec2",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resource_tag_mapping_list.*.account_id", "data.aws_caller_identity.current", "account_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourcesTagFilterDataSourceConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -169,3 +208,38 @@ data "aws_resourcegroupstaggingapi_resources" "test" {
 }
 `, rName)
 }
+
+func testAccResourcesRegionsDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Key = %[1]q
+  }
+}
+
+resource "aws_vpc" "alternate" {
+  provider = awsalternate
+
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Key = %[1]q
+  }
+}
+
+data "aws_resourcegroupstaggingapi_resources" "test" {
+  regions = [%[2]q, %[3]q]
+
+  tag_filter {
+    key    = "Key"
+    values = [%[1]q]
+  }
+
+  depends_on = [aws_vpc.test, aws_vpc.alternate]
+}
+`, rName, acctest.Region(), acctest.AlternateRegion()))
+}
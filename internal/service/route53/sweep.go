@@ -55,7 +55,7 @@ func sweepHealthchecks(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -106,7 +106,7 @@ func sweepKeySigningKeys(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -154,7 +154,9 @@ func sweepKeySigningKeys(region string) error {
 				d.Set("name", dns.Name)
 				d.Set("status", dns.Status)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+					WithCreationTime(aws.TimeValue(dns.CreatedDate)).
+					WithName(aws.StringValue(dns.Name)))
 			}
 
 		}
@@ -179,11 +181,15 @@ func sweepKeySigningKeys(region string) error {
 }
 
 func sweepQueryLogs(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_route53_query_log", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListQueryLoggingConfigsPages(&route53.ListQueryLoggingConfigsInput{}, func(page *route53.ListQueryLoggingConfigsOutput, lastPage bool) bool {
@@ -228,7 +234,7 @@ func sweepZones(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -260,7 +266,8 @@ func sweepZones(region string) error {
 			d.Set("force_destroy", true)
 			d.Set("name", detail.Name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithName(aws.StringValue(detail.Name)))
 		}
 
 		return !lastPage
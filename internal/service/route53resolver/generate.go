@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run ../../generate/tagresource/main.go -TFResourceType=aws_route53_resolver_tag
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ParentNotFoundErrCode=ResourceNotFoundException -ServiceTagsSlice -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package route53resolver
@@ -39,12 +39,16 @@ func init() {
 }
 
 func sweepBucketObjects(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_s3_bucket_object", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).S3ConnURICleaningDisabled
+	conn := client.(*conns.AWSClient).S3ConnURICleaningDisabled()
 	input := &s3.ListBucketsInput{}
 
 	output, err := conn.ListBuckets(input)
@@ -112,12 +116,16 @@ func sweepBucketObjects(region string) error {
 }
 
 func sweepBuckets(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_s3_bucket", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).S3Conn
+	conn := client.(*conns.AWSClient).S3Conn()
 	input := &s3.ListBucketsInput{}
 
 	output, err := conn.ListBuckets(input)
@@ -29,11 +29,15 @@ func init() {
 }
 
 func sweepDiscoverers(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_schemas_discoverer", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SchemasConn
+	conn := client.(*conns.AWSClient).SchemasConn()
 	input := &schemas.ListDiscoverersInput{}
 	var sweeperErrs *multierror.Error
 
@@ -71,11 +75,15 @@ func sweepDiscoverers(region string) error {
 }
 
 func sweepRegistries(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_schemas_registry", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SchemasConn
+	conn := client.(*conns.AWSClient).SchemasConn()
 	input := &schemas.ListRegistriesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -59,6 +59,11 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"basic":      testAccFindingAggregator_basic,
 			"disappears": testAccFindingAggregator_disappears,
 		},
+		"Tag": {
+			"basic":      testAccTag_basic,
+			"disappears": testAccTag_disappears,
+			"value":      testAccTag_value,
+		},
 	}
 
 	for group, m := range testCases {
@@ -39,11 +39,15 @@ func init() {
 }
 
 func sweepConfigurationSets(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ses_configuration_set", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SESConn
+	conn := client.(*conns.AWSClient).SESConn()
 	input := &ses.ListConfigurationSetsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -86,11 +90,15 @@ func sweepConfigurationSets(region string) error {
 }
 
 func sweepIdentities(region, identityType string) error {
+	if sweep.SkipUnfilteredSweep(fmt.Sprintf("SES %s identity", identityType), region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SESConn
+	conn := client.(*conns.AWSClient).SESConn()
 	input := &ses.ListIdentitiesInput{
 		IdentityType: aws.String(identityType),
 	}
@@ -130,11 +138,15 @@ func sweepIdentities(region, identityType string) error {
 }
 
 func sweepReceiptRuleSets(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ses_receipt_rule_set", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SESConn
+	conn := client.(*conns.AWSClient).SESConn()
 
 	// You cannot delete the receipt rule set that is currently active.
 	// Setting the name of the receipt rule set to make active to null disables all email receiving.
@@ -49,11 +49,15 @@ func init() {
 }
 
 func sweepPlatformApplications(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_sns_platform_application", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SNSConn
+	conn := client.(*conns.AWSClient).SNSConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListPlatformApplicationsPages(&sns.ListPlatformApplicationsInput{}, func(page *sns.ListPlatformApplicationsOutput, lastPage bool) bool {
@@ -94,11 +98,15 @@ func sweepPlatformApplications(region string) error {
 }
 
 func sweepTopics(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_sns_topic", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SNSConn
+	conn := client.(*conns.AWSClient).SNSConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListTopicsPages(&sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, lastPage bool) bool {
@@ -51,7 +51,7 @@ func ResourceQueuePolicy() *schema.Resource {
 }
 
 func resourceQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	policyAttributes := map[string]string{
 		sqs.QueueAttributeNamePolicy: d.Get("policy").(string),
@@ -81,7 +81,7 @@ func resourceQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueuePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	policy, err := FindQueuePolicyByURL(conn, d.Id())
 
@@ -102,7 +102,7 @@ func resourceQueuePolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueuePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	log.Printf("[DEBUG] Deleting SQS Queue Policy: %s", d.Id())
 	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
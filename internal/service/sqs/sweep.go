@@ -32,11 +32,15 @@ func init() {
 }
 
 func sweepQueues(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_sqs_queue", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SQSConn
+	conn := client.(*conns.AWSClient).SQSConn()
 	input := &sqs.ListQueuesInput{}
 	var sweeperErrs *multierror.Error
 
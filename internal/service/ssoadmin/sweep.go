@@ -33,12 +33,16 @@ func init() {
 }
 
 func sweepAccountAssignments(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ssoadmin_account_assignment", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).SSOAdminConn
+	conn := client.(*conns.AWSClient).SSOAdminConn()
 	var sweeperErrs *multierror.Error
 
 	// Need to Read the SSO Instance first; assumes the first instance returned
@@ -140,12 +144,16 @@ func sweepAccountAssignments(region string) error {
 }
 
 func sweepPermissionSets(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_ssoadmin_permission_set", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).SSOAdminConn
+	conn := client.(*conns.AWSClient).SSOAdminConn()
 	var sweeperErrs *multierror.Error
 
 	// Need to Read the SSO Instance first; assumes the first instance returned
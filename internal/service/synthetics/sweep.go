@@ -28,11 +28,15 @@ func init() {
 }
 
 func sweepCanaries(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_synthetics_canary", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).SyntheticsConn
+	conn := client.(*conns.AWSClient).SyntheticsConn()
 	input := &synthetics.DescribeCanariesInput{}
 	var sweeperErrs *multierror.Error
 
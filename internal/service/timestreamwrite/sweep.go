@@ -31,11 +31,15 @@ func init() {
 }
 
 func sweepDatabases(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_timestreamwrite_database", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TimestreamWriteConn
+	conn := client.(*conns.AWSClient).TimestreamWriteConn()
 	ctx := context.Background()
 
 	var sweeperErrs *multierror.Error
@@ -88,11 +92,15 @@ func sweepDatabases(region string) error {
 }
 
 func sweepTables(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_timestreamwrite_table", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TimestreamWriteConn
+	conn := client.(*conns.AWSClient).TimestreamWriteConn()
 	ctx := context.Background()
 
 	var sweeperErrs *multierror.Error
@@ -38,6 +38,11 @@ func TestAccTransfer_serial(t *testing.T) {
 		"SSHKey": {
 			"basic": testAccSSHKey_basic,
 		},
+		"Tag": {
+			"basic":      testAccTag_basic,
+			"disappears": testAccTag_disappears,
+			"value":      testAccTag_value,
+		},
 		"User": {
 			"basic":                 testAccUser_basic,
 			"disappears":            testAccUser_disappears,
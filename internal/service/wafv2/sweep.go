@@ -50,11 +50,15 @@ func init() {
 }
 
 func sweepIPSets(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_wafv2_ip_set", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -102,11 +106,15 @@ func sweepIPSets(region string) error {
 }
 
 func sweepRegexPatternSets(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_wafv2_regex_pattern_set", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -154,11 +162,15 @@ func sweepRegexPatternSets(region string) error {
 }
 
 func sweepRuleGroups(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_wafv2_rule_group", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -212,7 +224,7 @@ func sweepWebACLs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -249,7 +261,8 @@ func sweepWebACLs(region string) error {
 			d.Set("name", name)
 			d.Set("scope", input.Scope)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithName(name))
 		}
 
 		return !lastPage
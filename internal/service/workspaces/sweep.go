@@ -38,7 +38,7 @@ func sweepDirectories(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WorkSpacesConn
+	conn := client.(*conns.AWSClient).WorkSpacesConn()
 	input := &workspaces.DescribeWorkspaceDirectoriesInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -52,7 +52,8 @@ func sweepDirectories(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(directory.DirectoryId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithName(aws.StringValue(directory.DirectoryName)))
 		}
 
 		return !lastPage
@@ -81,7 +82,7 @@ func sweepIPGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WorkSpacesConn
+	conn := client.(*conns.AWSClient).WorkSpacesConn()
 	input := &workspaces.DescribeIpGroupsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -95,7 +96,8 @@ func sweepIPGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(ipGroup.GroupId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client).
+				WithName(aws.StringValue(ipGroup.GroupName)))
 		}
 
 		return !lastPage
@@ -120,11 +122,15 @@ func sweepIPGroups(region string) error {
 }
 
 func sweepWorkspace(region string) error {
+	if sweep.SkipUnfilteredSweep("aws_workspaces_workspace", region) {
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).WorkSpacesConn
+	conn := client.(*conns.AWSClient).WorkSpacesConn()
 
 	var errors error
 	input := &workspaces.DescribeWorkspacesInput{}
@@ -0,0 +1,144 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// SweepFilter restricts the resources that are swept, for use in accounts
+// shared with resources that must not be deleted.
+// A resource is swept only if it passes every configured filter. A resource
+// whose sweeper does not provide the information a filter needs is skipped.
+type SweepFilter struct {
+	// MinAge is the minimum time since a resource was created.
+	MinAge time.Duration
+
+	// NamePrefixes are the allowed resource name prefixes.
+	NamePrefixes []string
+
+	// TagKey is the key of a tag that resources must have.
+	TagKey string
+
+	// TagValue is the value that the TagKey tag must have. Any value is
+	// allowed if empty.
+	TagValue string
+}
+
+// DefaultSweepFilter returns the sweep filter configured by environment variables.
+func DefaultSweepFilter() (SweepFilter, error) {
+	var filter SweepFilter
+
+	if v := os.Getenv(conns.EnvVarSweepMinAge); v != "" {
+		d, err := time.ParseDuration(v)
+
+		if err != nil {
+			return filter, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepMinAge, err)
+		}
+
+		filter.MinAge = d
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepNamePrefixes); v != "" {
+		for _, prefix := range strings.Split(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				filter.NamePrefixes = append(filter.NamePrefixes, prefix)
+			}
+		}
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepTag); v != "" {
+		parts := strings.SplitN(v, "=", 2)
+
+		filter.TagKey = parts[0]
+
+		if len(parts) == 2 {
+			filter.TagValue = parts[1]
+		}
+	}
+
+	return filter, nil
+}
+
+// IsEmpty returns whether the filter allows all resources.
+func (f SweepFilter) IsEmpty() bool {
+	return f.MinAge == 0 && len(f.NamePrefixes) == 0 && f.TagKey == ""
+}
+
+// SkipUnfilteredSweep returns whether a sweeper that cannot apply the sweep
+// filter, because it does not delete resources using SweepOrchestrator, must
+// not run. Such sweepers are skipped whenever a filter is configured, or the
+// filter configuration is invalid, so that they never delete resources that
+// the filter would protect.
+func SkipUnfilteredSweep(name, region string) bool {
+	filter, err := DefaultSweepFilter()
+
+	if err != nil {
+		log.Printf("[WARN] Skipping %s sweep for %s: %s", name, region, err)
+
+		return true
+	}
+
+	if !filter.IsEmpty() {
+		log.Printf("[WARN] Skipping %s sweep for %s: sweeper does not support sweep filters", name, region)
+
+		return true
+	}
+
+	return false
+}
+
+// SkipReason returns why the resource does not pass the filter at the specified
+// time, or an empty string if it does.
+func (f SweepFilter) SkipReason(sr *SweepResource, now time.Time) string {
+	if f.TagKey != "" {
+		v, ok := sr.tags[f.TagKey]
+
+		switch {
+		case sr.tags == nil:
+			return fmt.Sprintf("tags unknown, required tag %q", f.TagKey)
+		case !ok:
+			return fmt.Sprintf("missing required tag %q", f.TagKey)
+		case f.TagValue != "" && v != f.TagValue:
+			return fmt.Sprintf("tag %q has value %q, required %q", f.TagKey, v, f.TagValue)
+		}
+	}
+
+	if len(f.NamePrefixes) > 0 {
+		if sr.name == "" {
+			return fmt.Sprintf("name unknown, required prefix %q", f.NamePrefixes)
+		}
+
+		allowed := false
+
+		for _, prefix := range f.NamePrefixes {
+			if strings.HasPrefix(sr.name, prefix) {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return fmt.Sprintf("name %q does not have a prefix in %q", sr.name, f.NamePrefixes)
+		}
+	}
+
+	if f.MinAge > 0 {
+		if sr.creationTime.IsZero() {
+			return fmt.Sprintf("creation time unknown, required minimum age %s", f.MinAge)
+		}
+
+		if age := now.Sub(sr.creationTime); age < f.MinAge {
+			return fmt.Sprintf("age %s is less than minimum age %s", age.Round(time.Second), f.MinAge)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,243 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestSweepFilterSkipReason(t *testing.T) {
+	now := time.Date(2021, time.November, 1, 12, 0, 0, 0, time.UTC)
+	r := (&testSweepRecorder{}).resource(func(string) error { return nil })
+
+	testCases := []struct {
+		Name          string
+		Filter        SweepFilter
+		SweepResource *SweepResource
+		ExpectSkip    bool
+	}{
+		{
+			Name:          "no filter",
+			SweepResource: testSweepResource(r, "r-1"),
+		},
+		{
+			Name:          "tag key match",
+			Filter:        SweepFilter{TagKey: "team"},
+			SweepResource: testSweepResource(r, "r-1").WithTags(map[string]string{"team": "platform"}),
+		},
+		{
+			Name:          "tag key and value match",
+			Filter:        SweepFilter{TagKey: "team", TagValue: "platform"},
+			SweepResource: testSweepResource(r, "r-1").WithTags(map[string]string{"team": "platform"}),
+		},
+		{
+			Name:          "tag value mismatch",
+			Filter:        SweepFilter{TagKey: "team", TagValue: "platform"},
+			SweepResource: testSweepResource(r, "r-1").WithTags(map[string]string{"team": "data"}),
+			ExpectSkip:    true,
+		},
+		{
+			Name:          "tag missing",
+			Filter:        SweepFilter{TagKey: "team"},
+			SweepResource: testSweepResource(r, "r-1").WithTags(nil),
+			ExpectSkip:    true,
+		},
+		{
+			Name:          "tags unknown",
+			Filter:        SweepFilter{TagKey: "team"},
+			SweepResource: testSweepResource(r, "r-1"),
+			ExpectSkip:    true,
+		},
+		{
+			Name:          "name prefix match",
+			Filter:        SweepFilter{NamePrefixes: []string{"other-", "tf-acc-test"}},
+			SweepResource: testSweepResource(r, "r-1").WithName("tf-acc-test-12345"),
+		},
+		{
+			Name:          "name prefix mismatch",
+			Filter:        SweepFilter{NamePrefixes: []string{"tf-acc-test"}},
+			SweepResource: testSweepResource(r, "r-1").WithName("production"),
+			ExpectSkip:    true,
+		},
+		{
+			Name:          "name unknown",
+			Filter:        SweepFilter{NamePrefixes: []string{"tf-acc-test"}},
+			SweepResource: testSweepResource(r, "r-1"),
+			ExpectSkip:    true,
+		},
+		{
+			Name:          "old enough",
+			Filter:        SweepFilter{MinAge: 24 * time.Hour},
+			SweepResource: testSweepResource(r, "r-1").WithCreationTime(now.Add(-25 * time.Hour)),
+		},
+		{
+			Name:          "too young",
+			Filter:        SweepFilter{MinAge: 24 * time.Hour},
+			SweepResource: testSweepResource(r, "r-1").WithCreationTime(now.Add(-1 * time.Hour)),
+			ExpectSkip:    true,
+		},
+		{
+			Name:          "creation time unknown",
+			Filter:        SweepFilter{MinAge: 24 * time.Hour},
+			SweepResource: testSweepResource(r, "r-1"),
+			ExpectSkip:    true,
+		},
+		{
+			Name:   "all match",
+			Filter: SweepFilter{MinAge: time.Hour, NamePrefixes: []string{"tf-acc-test"}, TagKey: "team", TagValue: "platform"},
+			SweepResource: testSweepResource(r, "r-1").
+				WithCreationTime(now.Add(-2 * time.Hour)).
+				WithName("tf-acc-test-12345").
+				WithTags(map[string]string{"team": "platform"}),
+		},
+		{
+			Name:   "one mismatch",
+			Filter: SweepFilter{MinAge: time.Hour, NamePrefixes: []string{"tf-acc-test"}, TagKey: "team", TagValue: "platform"},
+			SweepResource: testSweepResource(r, "r-1").
+				WithCreationTime(now.Add(-2 * time.Hour)).
+				WithName("production").
+				WithTags(map[string]string{"team": "platform"}),
+			ExpectSkip: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			reason := testCase.Filter.SkipReason(testCase.SweepResource, now)
+
+			if got, expected := reason != "", testCase.ExpectSkip; got != expected {
+				t.Errorf("got skip %t (reason %q), expected %t", got, reason, expected)
+			}
+		})
+	}
+}
+
+func TestDefaultSweepFilter(t *testing.T) {
+	for k, v := range map[string]string{
+		conns.EnvVarSweepMinAge:       "36h",
+		conns.EnvVarSweepNamePrefixes: "tf-acc-test, tf-test-",
+		conns.EnvVarSweepTag:          "owner=ci=true",
+	} {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+
+	filter, err := DefaultSweepFilter()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := SweepFilter{
+		MinAge:       36 * time.Hour,
+		NamePrefixes: []string{"tf-acc-test", "tf-test-"},
+		TagKey:       "owner",
+		TagValue:     "ci=true",
+	}
+
+	if !reflect.DeepEqual(filter, expected) {
+		t.Errorf("got %#v, expected %#v", filter, expected)
+	}
+
+	os.Setenv(conns.EnvVarSweepMinAge, "a day")
+
+	if _, err := DefaultSweepFilter(); err == nil {
+		t.Error("expected error for invalid minimum age, got none")
+	}
+}
+
+func TestSkipUnfilteredSweep(t *testing.T) {
+	envVars := []string{
+		conns.EnvVarSweepMinAge,
+		conns.EnvVarSweepNamePrefixes,
+		conns.EnvVarSweepTag,
+	}
+
+	for _, k := range envVars {
+		if v, ok := os.LookupEnv(k); ok {
+			defer os.Setenv(k, v)
+		} else {
+			defer os.Unsetenv(k)
+		}
+	}
+
+	testCases := []struct {
+		Name       string
+		Env        map[string]string
+		ExpectSkip bool
+	}{
+		{
+			Name: "no filter",
+		},
+		{
+			Name:       "minimum age",
+			Env:        map[string]string{conns.EnvVarSweepMinAge: "24h"},
+			ExpectSkip: true,
+		},
+		{
+			Name:       "name prefixes",
+			Env:        map[string]string{conns.EnvVarSweepNamePrefixes: "tf-acc-test"},
+			ExpectSkip: true,
+		},
+		{
+			Name:       "tag",
+			Env:        map[string]string{conns.EnvVarSweepTag: "owner"},
+			ExpectSkip: true,
+		},
+		{
+			Name:       "invalid filter",
+			Env:        map[string]string{conns.EnvVarSweepMinAge: "a day"},
+			ExpectSkip: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			for _, k := range envVars {
+				os.Unsetenv(k)
+			}
+
+			for k, v := range testCase.Env {
+				os.Setenv(k, v)
+			}
+
+			if got := SkipUnfilteredSweep("aws_example_thing", "us-west-2"); got != testCase.ExpectSkip { //lintignore:AWSAT003
+				t.Errorf("got %t, expected %t", got, testCase.ExpectSkip)
+			}
+		})
+	}
+}
+
+func TestSweepOrchestratorWithOptionsFilter(t *testing.T) {
+	recorder := &testSweepRecorder{}
+	r := recorder.resource(func(string) error { return nil })
+
+	sweepResources := []*SweepResource{
+		testSweepResource(r, "ours").WithName("tf-acc-test-1"),
+		testSweepResource(r, "theirs").WithName("production"),
+	}
+
+	summary, err := SweepOrchestratorWithOptions(context.Background(), sweepResources, SweepOptions{
+		Filter:  SweepFilter{NamePrefixes: []string{"tf-acc-test"}},
+		Timeout: time.Minute,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"ours"}; !reflect.DeepEqual(recorder.deleted, expected) {
+		t.Errorf("got %v deleted, expected %v", recorder.deleted, expected)
+	}
+
+	if got, expected := summary.Count(sweepResources[0].TypeName(), SweepStatusSkipped), 1; got != expected {
+		t.Errorf("got %d skipped, expected %d", got, expected)
+	}
+}
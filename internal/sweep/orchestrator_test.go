@@ -0,0 +1,245 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testSweepRecorder records the order and concurrency of deletions.
+type testSweepRecorder struct {
+	active    int32
+	deleted   []string
+	lock      sync.Mutex
+	maxActive int32
+}
+
+func (r *testSweepRecorder) resource(deleteFunc func(id string) error) *schema.Resource {
+	return &schema.Resource{
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			active := atomic.AddInt32(&r.active, 1)
+			defer atomic.AddInt32(&r.active, -1)
+
+			for {
+				max := atomic.LoadInt32(&r.maxActive)
+
+				if active <= max || atomic.CompareAndSwapInt32(&r.maxActive, max, active) {
+					break
+				}
+			}
+
+			time.Sleep(5 * time.Millisecond)
+
+			if err := deleteFunc(d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+
+			r.lock.Lock()
+			defer r.lock.Unlock()
+
+			r.deleted = append(r.deleted, d.Id())
+
+			return nil
+		},
+	}
+}
+
+// testSyncBuffer is a bytes.Buffer that may be written concurrently.
+type testSyncBuffer struct {
+	buf  bytes.Buffer
+	lock sync.Mutex
+}
+
+func (b *testSyncBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *testSyncBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.buf.String()
+}
+
+func testSweepResource(r *schema.Resource, id string) *SweepResource {
+	d := r.Data(nil)
+	d.SetId(id)
+
+	return NewSweepResource(r, d, nil)
+}
+
+func TestSweepOrchestratorWithOptionsConcurrency(t *testing.T) {
+	recorder := &testSweepRecorder{}
+	r := recorder.resource(func(string) error { return nil })
+
+	var sweepResources []*SweepResource
+
+	for i := 0; i < 20; i++ {
+		sweepResources = append(sweepResources, testSweepResource(r, fmt.Sprintf("r-%d", i)))
+	}
+
+	summary, err := SweepOrchestratorWithOptions(context.Background(), sweepResources, SweepOptions{Concurrency: 3, Timeout: time.Minute})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := int(recorder.maxActive), 3; got > expected {
+		t.Errorf("got %d concurrent deletions, expected at most %d", got, expected)
+	}
+
+	typeName := sweepResources[0].TypeName()
+
+	if got, expected := summary.Count(typeName, SweepStatusDeleted), 20; got != expected {
+		t.Errorf("got %d deleted, expected %d", got, expected)
+	}
+}
+
+func TestSweepOrchestratorWithOptionsContext(t *testing.T) {
+	type contextKey struct{}
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), contextKey{}, "example"))
+	defer cancel()
+
+	var got interface{}
+	r := &schema.Resource{
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			got = ctx.Value(contextKey{})
+
+			return nil
+		},
+	}
+
+	if _, err := SweepOrchestratorWithOptions(ctx, []*SweepResource{testSweepResource(r, "example")}, SweepOptions{Timeout: time.Minute}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "example"; got != expected {
+		t.Errorf("got context value %v, expected %v", got, expected)
+	}
+}
+
+func TestSweepOrchestratorWithOptionsDryRun(t *testing.T) {
+	recorder := &testSweepRecorder{}
+	r := recorder.resource(func(string) error { return nil })
+
+	sweepResources := []*SweepResource{
+		testSweepResource(r, "r-1"),
+		testSweepResource(r, "r-2"),
+	}
+
+	summary, err := SweepOrchestratorWithOptions(context.Background(), sweepResources, SweepOptions{DryRun: true})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := len(recorder.deleted); got != 0 {
+		t.Errorf("got %d deleted in dry run, expected none", got)
+	}
+
+	if got, expected := summary.Count(sweepResources[0].TypeName(), SweepStatusSkipped), 2; got != expected {
+		t.Errorf("got %d skipped, expected %d", got, expected)
+	}
+}
+
+func TestSweepOrchestratorWithOptionsSummary(t *testing.T) {
+	var attempts int32
+
+	var logs testSyncBuffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	recorder := &testSweepRecorder{}
+	r := recorder.resource(func(id string) error {
+		switch id {
+		case "dependent":
+			// Succeeds once its dependents are gone.
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				return errors.New("DependencyViolation: resource has a dependent object")
+			}
+		case "failed":
+			return errors.New("InvalidParameterValue: boom")
+		}
+
+		return nil
+	})
+
+	unsupported := &schema.Resource{
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return awserr.New("UnsupportedOperation", "not supported in this region", nil)
+		},
+	}
+
+	sweepResources := []*SweepResource{
+		testSweepResource(r, "deleted"),
+		testSweepResource(r, "dependent"),
+		testSweepResource(r, "failed"),
+		testSweepResource(unsupported, "skipped"),
+	}
+
+	summary, err := SweepOrchestratorWithOptions(context.Background(), sweepResources, SweepOptions{PollInterval: time.Millisecond, Timeout: time.Minute})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	typeName := sweepResources[0].TypeName()
+	unsupportedTypeName := sweepResources[3].TypeName()
+
+	if typeName == unsupportedTypeName {
+		t.Fatalf("expected different type names, got %s", typeName)
+	}
+
+	for status, expected := range map[string]int{
+		SweepStatusDeleted: 2,
+		SweepStatusFailed:  1,
+		SweepStatusSkipped: 0,
+	} {
+		if got := summary.Count(typeName, status); got != expected {
+			t.Errorf("got %d %s, expected %d", got, status, expected)
+		}
+	}
+
+	if got, expected := summary.Count(unsupportedTypeName, SweepStatusSkipped), 1; got != expected {
+		t.Errorf("got %d %s skipped, expected %d", got, unsupportedTypeName, expected)
+	}
+
+	for _, result := range summary.Results {
+		if result.ID == "skipped" && result.Err == nil {
+			t.Error("expected skipped result to record its error, got none")
+		}
+	}
+
+	if expected := fmt.Sprintf("[WARN] Skipping %s (skipped) sweep: UnsupportedOperation", unsupportedTypeName); !strings.Contains(logs.String(), expected) {
+		t.Errorf("expected log to contain %q, got:\n%s", expected, logs.String())
+	}
+
+	expectedSummary := fmt.Sprintf("%[1]s: 2 deleted, 0 skipped, 1 failed\n%[2]s: 0 deleted, 1 skipped, 0 failed", typeName, unsupportedTypeName)
+
+	if typeName > unsupportedTypeName {
+		expectedSummary = fmt.Sprintf("%[2]s: 0 deleted, 1 skipped, 0 failed\n%[1]s: 2 deleted, 0 skipped, 1 failed", typeName, unsupportedTypeName)
+	}
+
+	if got := summary.String(); got != expectedSummary {
+		t.Errorf("got summary %q, expected %q", got, expectedSummary)
+	}
+}
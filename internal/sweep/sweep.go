@@ -8,8 +8,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -91,9 +95,12 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 }
 
 type SweepResource struct {
-	d        *schema.ResourceData
-	meta     interface{}
-	resource *schema.Resource
+	creationTime time.Time
+	d            *schema.ResourceData
+	meta         interface{}
+	name         string
+	resource     *schema.Resource
+	tags         map[string]string
 }
 
 func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
@@ -104,41 +111,333 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta in
 	}
 }
 
+// WithCreationTime sets the creation time of the resource, used by SweepFilter.MinAge.
+func (sr *SweepResource) WithCreationTime(t time.Time) *SweepResource {
+	sr.creationTime = t
+
+	return sr
+}
+
+// WithName sets the name of the resource, used by SweepFilter.NamePrefixes.
+func (sr *SweepResource) WithName(name string) *SweepResource {
+	sr.name = name
+
+	return sr
+}
+
+// WithTags sets the tags of the resource, used by SweepFilter.TagKey.
+func (sr *SweepResource) WithTags(tags map[string]string) *SweepResource {
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+
+	sr.tags = tags
+
+	return sr
+}
+
+// ID returns the ID of the resource.
+func (sr *SweepResource) ID() string {
+	return sr.d.Id()
+}
+
+// TypeName returns a name identifying the type of the resource.
+// It is derived from the name of the resource's delete function, e.g.
+// "ec2.resourceVPCDelete", as *schema.Resource does not record the type name.
+func (sr *SweepResource) TypeName() string {
+	var f interface{}
+
+	switch {
+	case sr.resource.DeleteContext != nil:
+		f = sr.resource.DeleteContext
+	case sr.resource.DeleteWithoutTimeout != nil:
+		f = sr.resource.DeleteWithoutTimeout
+	case sr.resource.Delete != nil:
+		f = sr.resource.Delete
+	default:
+		return "unknown"
+	}
+
+	name := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return name
+}
+
+const (
+	// DefaultSweepConcurrency is the default maximum number of resources deleted concurrently.
+	DefaultSweepConcurrency = 10
+)
+
+// SweepOptions configures SweepOrchestratorWithOptions.
+type SweepOptions struct {
+	// Concurrency is the maximum number of resources deleted concurrently.
+	// Defaults to DefaultSweepConcurrency.
+	Concurrency int
+
+	// DryRun logs the resources that would be deleted without deleting them.
+	DryRun bool
+
+	// Filter skips resources that do not pass it.
+	Filter SweepFilter
+
+	// Retry timing for resources whose deletion fails with a retryable error.
+	// See tfresource.RetryConfigContext.
+	Delay        time.Duration
+	DelayRand    time.Duration
+	MinTimeout   time.Duration
+	PollInterval time.Duration
+	Timeout      time.Duration
+}
+
+// DefaultSweepOptions returns the sweep options configured by environment variables.
+func DefaultSweepOptions() (SweepOptions, error) {
+	opts := SweepOptions{
+		Concurrency: DefaultSweepConcurrency,
+		Timeout:     SweepThrottlingRetryTimeout,
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepConcurrency); v != "" {
+		n, err := strconv.Atoi(v)
+
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepConcurrency, err)
+		}
+
+		opts.Concurrency = n
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepDryRun); v != "" {
+		b, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepDryRun, err)
+		}
+
+		opts.DryRun = b
+	}
+
+	filter, err := DefaultSweepFilter()
+
+	if err != nil {
+		return opts, err
+	}
+
+	opts.Filter = filter
+
+	return opts, nil
+}
+
+// Outcomes of sweeping a resource.
+const (
+	SweepStatusDeleted = "deleted"
+	SweepStatusFailed  = "failed"
+	SweepStatusSkipped = "skipped"
+)
+
+// SweepResult is the outcome of sweeping a single resource.
+type SweepResult struct {
+	Err      error
+	ID       string
+	Status   string
+	TypeName string
+}
+
+// SweepSummary records the outcome of sweeping each resource.
+type SweepSummary struct {
+	lock    sync.Mutex
+	Results []SweepResult
+}
+
+func (s *SweepSummary) add(result SweepResult) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.Results = append(s.Results, result)
+}
+
+// Count returns the number of resources of the specified type with the specified status.
+func (s *SweepSummary) Count(typeName, status string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	n := 0
+
+	for _, result := range s.Results {
+		if result.TypeName == typeName && result.Status == status {
+			n++
+		}
+	}
+
+	return n
+}
+
+// TypeNames returns the sorted resource type names in the summary.
+func (s *SweepSummary) TypeNames() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var typeNames []string
+	seen := make(map[string]bool)
+
+	for _, result := range s.Results {
+		if !seen[result.TypeName] {
+			seen[result.TypeName] = true
+			typeNames = append(typeNames, result.TypeName)
+		}
+	}
+
+	sort.Strings(typeNames)
+
+	return typeNames
+}
+
+// String returns one line per resource type with the number of resources deleted, skipped and failed.
+func (s *SweepSummary) String() string {
+	var lines []string
+
+	for _, typeName := range s.TypeNames() {
+		lines = append(lines, fmt.Sprintf("%s: %d %s, %d %s, %d %s", typeName,
+			s.Count(typeName, SweepStatusDeleted), SweepStatusDeleted,
+			s.Count(typeName, SweepStatusSkipped), SweepStatusSkipped,
+			s.Count(typeName, SweepStatusFailed), SweepStatusFailed))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	opts, err := DefaultSweepOptions()
+
+	if err != nil {
+		return err
+	}
+
+	opts.Delay = delay
+	opts.DelayRand = delayRand
+	opts.MinTimeout = minTimeout
+	opts.PollInterval = pollInterval
+	opts.Timeout = timeout
+
+	summary, err := SweepOrchestratorWithOptions(ctx, sweepResources, opts)
+
+	if summary := summary.String(); summary != "" {
+		log.Printf("[INFO] Sweep summary:\n%s", summary)
+	}
+
+	return err
+}
+
+// SweepOrchestratorWithOptions deletes the specified resources, at most
+// opts.Concurrency at once, and returns a summary of the outcome for each resource.
+func SweepOrchestratorWithOptions(ctx context.Context, sweepResources []*SweepResource, opts SweepOptions) (*SweepSummary, error) {
+	concurrency := opts.Concurrency
+
+	if concurrency <= 0 {
+		concurrency = DefaultSweepConcurrency
+	}
+
+	now := time.Now()
+	summary := &SweepSummary{}
+	sem := make(chan struct{}, concurrency)
 	var g multierror.Group
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
-		g.Go(func() error {
-			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
-				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+		result := SweepResult{
+			ID:       sweepResource.ID(),
+			TypeName: sweepResource.TypeName(),
+		}
 
-				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
-						return resource.RetryableError(err)
-					}
+		if reason := opts.Filter.SkipReason(sweepResource, now); reason != "" {
+			log.Printf("[INFO] Skipping %s (%s): %s", result.TypeName, result.ID, reason)
+			result.Status = SweepStatusSkipped
+			summary.add(result)
 
-					return resource.NonRetryableError(err)
-				}
+			continue
+		}
+
+		if opts.DryRun {
+			log.Printf("[INFO] Dry run: would delete %s (%s)", result.TypeName, result.ID)
+			result.Status = SweepStatusSkipped
+			summary.add(result)
 
-				return nil
-			})
+			continue
+		}
+
+		// Acquired before starting the goroutine so that at most concurrency
+		// goroutines exist at once.
+		sem <- struct{}{}
 
-			if tfresource.TimedOut(err) {
-				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+		g.Go(func() error {
+			defer func() { <-sem }()
+
+			err := sweepResource.delete(ctx, opts)
+
+			switch {
+			case err == nil:
+				result.Status = SweepStatusDeleted
+			case SkipSweepError(err):
+				log.Printf("[WARN] Skipping %s (%s) sweep: %s", result.TypeName, result.ID, err)
+				result.Status = SweepStatusSkipped
+				result.Err = err
+				err = nil
+			default:
+				result.Status = SweepStatusFailed
+				result.Err = err
+				err = fmt.Errorf("error sweeping %s (%s): %w", result.TypeName, result.ID, err)
 			}
 
+			summary.add(result)
+
 			return err
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	return summary, g.Wait().ErrorOrNil()
+}
+
+// sweepRetryableErrors are the error codes on which deletion of a resource is
+// retried. Besides throttling, these include errors caused by dependent
+// resources that are still being deleted.
+var sweepRetryableErrors = []string{
+	"DependencyViolation",
+	"RequestLimitExceeded",
+	"Throttling",
+	"TooManyRequestsException",
+}
+
+func (sr *SweepResource) delete(ctx context.Context, opts SweepOptions) error {
+	err := tfresource.RetryConfigContext(ctx, opts.Delay, opts.DelayRand, opts.MinTimeout, opts.PollInterval, opts.Timeout, func() *resource.RetryError {
+		err := DeleteResourceContext(ctx, sr.resource, sr.d, sr.meta)
+
+		if err != nil {
+			for _, code := range sweepRetryableErrors {
+				if strings.Contains(err.Error(), code) {
+					log.Printf("[INFO] While sweeping resource (%s), encountered retryable error (%s). Retrying...", sr.d.Id(), err)
+					return resource.RetryableError(err)
+				}
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = DeleteResourceContext(ctx, sr.resource, sr.d, sr.meta)
+	}
+
+	return err
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -200,13 +499,19 @@ func SkipSweepError(err error) bool {
 }
 
 func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	return DeleteResourceContext(context.Background(), resource, d, meta)
+}
+
+// DeleteResourceContext deletes the resource, passing ctx to its delete function
+// if it takes one.
+func DeleteResourceContext(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
 
 		if resource.DeleteContext != nil {
-			diags = resource.DeleteContext(context.Background(), d, meta)
+			diags = resource.DeleteContext(ctx, d, meta)
 		} else {
-			diags = resource.DeleteWithoutTimeout(context.Background(), d, meta)
+			diags = resource.DeleteWithoutTimeout(ctx, d, meta)
 		}
 
 		for i := range diags {
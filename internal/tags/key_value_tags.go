@@ -28,6 +28,114 @@ type DefaultConfig struct {
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+
+	// Regular expressions matching tag keys to ignore.
+	KeyPatterns []*regexp.Regexp
+
+	// Tags to ignore only when their value matches a regular expression.
+	ValuePatterns []*IgnoreValuePattern
+}
+
+// IgnoreValuePattern ignores a tag key when its value matches a regular
+// expression.
+type IgnoreValuePattern struct {
+	Key     string
+	Pattern *regexp.Regexp
+}
+
+// RequiredConfig contains tags that must be present on all resources.
+type RequiredConfig struct {
+	Tags []*RequiredTag
+}
+
+// RequiredTag is a tag that must be present on all resources, except those of
+// the excluded resource types.
+type RequiredTag struct {
+	// Regular expressions, one of which the tag value must match.
+	// Any value is allowed if empty.
+	AllowedValues []*regexp.Regexp
+
+	// Resource types, such as aws_instance, that do not require the tag.
+	ExcludeResourceTypes []string
+
+	Key string
+}
+
+// Validate returns an error naming the required tags that are missing from the
+// given tags of a resource of the given type or that have a disallowed value.
+// Tags with a nil value are only checked for presence.
+func (rc *RequiredConfig) Validate(resourceType string, tags KeyValueTags) error {
+	if rc == nil {
+		return nil
+	}
+
+	var missingKeys []string
+	var invalidValues []string
+
+	for _, requiredTag := range rc.Tags {
+		if requiredTag.excludes(resourceType) {
+			continue
+		}
+
+		v, ok := tags[requiredTag.Key]
+
+		if !ok {
+			missingKeys = append(missingKeys, requiredTag.Key)
+			continue
+		}
+
+		if v == nil || v.Value == nil || requiredTag.allows(*v.Value) {
+			continue
+		}
+
+		var patterns []string
+
+		for _, allowedValue := range requiredTag.AllowedValues {
+			patterns = append(patterns, allowedValue.String())
+		}
+
+		invalidValues = append(invalidValues, fmt.Sprintf("tag %q value %q does not match any allowed value (%s)", requiredTag.Key, *v.Value, strings.Join(patterns, ", ")))
+	}
+
+	if len(missingKeys) == 0 && len(invalidValues) == 0 {
+		return nil
+	}
+
+	var problems []string
+
+	if len(missingKeys) > 0 {
+		sort.Strings(missingKeys)
+		problems = append(problems, fmt.Sprintf("missing required tag keys: %s", strings.Join(missingKeys, ", ")))
+	}
+
+	sort.Strings(invalidValues)
+	problems = append(problems, invalidValues...)
+
+	return fmt.Errorf(`"tags" do not satisfy the "required_tags" configuration block of the provider: %s`, strings.Join(problems, "; "))
+}
+
+func (rt *RequiredTag) allows(value string) bool {
+	if len(rt.AllowedValues) == 0 {
+		return true
+	}
+
+	for _, allowedValue := range rt.AllowedValues {
+		if allowedValue.MatchString(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rt *RequiredTag) excludes(resourceType string) bool {
+	for _, v := range rt.ExcludeResourceTypes {
+		if v == resourceType {
+			return true
+		}
+	}
+
+	return false
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -96,6 +204,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnorePatterns(config.KeyPatterns)
+	result = result.IgnoreValuePatterns(config.ValuePatterns)
 
 	return result
 }
@@ -147,6 +257,58 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnorePatterns returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnorePatterns(patterns []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, pattern := range patterns {
+			if pattern.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreValuePatterns returns tags whose value does not match the regular
+// expression of a value pattern for the same key.
+// Tags with a nil value are never ignored.
+func (tags KeyValueTags) IgnoreValuePatterns(valuePatterns []*IgnoreValuePattern) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		if v != nil && v.Value != nil {
+			for _, valuePattern := range valuePatterns {
+				if valuePattern.Key == k && valuePattern.Pattern.MatchString(*v.Value) {
+					ignore = true
+					break
+				}
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	result := make(KeyValueTags)
@@ -1,6 +1,7 @@
 package tags
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -291,6 +292,129 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRequiredConfigValidate(t *testing.T) {
+	requiredConfig := &RequiredConfig{
+		Tags: []*RequiredTag{
+			{
+				Key:           "cost-center",
+				AllowedValues: []*regexp.Regexp{regexp.MustCompile(`^\d{4}$`)},
+			},
+			{
+				Key:                  "owner",
+				ExcludeResourceTypes: []string{"aws_iam_role"},
+			},
+			{
+				Key: "environment",
+				AllowedValues: []*regexp.Regexp{
+					regexp.MustCompile(`^prod$`),
+					regexp.MustCompile(`^dev-`),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		resourceType   string
+		tags           KeyValueTags
+		wantErr        string
+	}{
+		{
+			name:           "no config",
+			requiredConfig: nil,
+			resourceType:   "aws_vpc",
+			tags:           New(map[string]string{}),
+		},
+		{
+			name:           "empty config",
+			requiredConfig: &RequiredConfig{},
+			resourceType:   "aws_vpc",
+			tags:           New(map[string]string{}),
+		},
+		{
+			name:           "all present",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_vpc",
+			tags: New(map[string]string{
+				"cost-center": "1234",
+				"environment": "dev-test",
+				"owner":       "team",
+				"other":       "value",
+			}),
+		},
+		{
+			name:           "no tags",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_vpc",
+			tags:           nil,
+			wantErr:        `"tags" do not satisfy the "required_tags" configuration block of the provider: missing required tag keys: cost-center, environment, owner`,
+		},
+		{
+			name:           "some missing",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_vpc",
+			tags: New(map[string]string{
+				"cost-center": "1234",
+			}),
+			wantErr: `"tags" do not satisfy the "required_tags" configuration block of the provider: missing required tag keys: environment, owner`,
+		},
+		{
+			name:           "excluded resource type",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_iam_role",
+			tags: New(map[string]string{
+				"cost-center": "1234",
+				"environment": "prod",
+			}),
+		},
+		{
+			name:           "disallowed values",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_vpc",
+			tags: New(map[string]string{
+				"cost-center": "12345",
+				"environment": "staging",
+			}),
+			wantErr: `"tags" do not satisfy the "required_tags" configuration block of the provider: missing required tag keys: owner; ` +
+				`tag "cost-center" value "12345" does not match any allowed value (^\d{4}$); ` +
+				`tag "environment" value "staging" does not match any allowed value (^prod$, ^dev-)`,
+		},
+		{
+			name:           "unknown values",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_vpc",
+			tags: KeyValueTags{
+				"cost-center": nil,
+				"environment": &TagData{},
+				"owner":       nil,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.requiredConfig.Validate(testCase.resourceType, testCase.tags)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error: %s", testCase.wantErr)
+			}
+
+			if got := err.Error(); got != testCase.wantErr {
+				t.Errorf("got error %q; want %q", got, testCase.wantErr)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -466,6 +590,71 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key patterns",
+			tags: New(map[string]string{
+				"scanner:2024-10:result": "pass",
+				"team-managed-by":        "terraform",
+				"Name":                   "example",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^scanner:\d{4}-\d{2}:`),
+					regexp.MustCompile(`-managed-by$`),
+				},
+			},
+			want: map[string]string{
+				"Name": "example",
+			},
+		},
+		{
+			name: "value patterns",
+			tags: New(map[string]string{
+				"Name":  "eks-node-1",
+				"Owner": "eks-node-2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ValuePatterns: []*IgnoreValuePattern{
+					{
+						Key:     "Name",
+						Pattern: regexp.MustCompile(`^eks-`),
+					},
+				},
+			},
+			want: map[string]string{
+				"Owner": "eks-node-2",
+			},
+		},
+		{
+			name: "keys, key prefixes, key patterns and value patterns",
+			tags: New(map[string]string{
+				"key1":  "value1",
+				"key2":  "value2",
+				"key3":  "value3",
+				"other": "value4",
+				"Name":  "managed",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+				KeyPrefixes: New([]string{
+					"key2",
+				}),
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^key\d$`),
+				},
+				ValuePatterns: []*IgnoreValuePattern{
+					{
+						Key:     "Name",
+						Pattern: regexp.MustCompile(`^managed$`),
+					},
+				},
+			},
+			want: map[string]string{
+				"other": "value4",
+			},
+		},
 		{
 			name: "key prefixes all exact",
 			tags: New(map[string]string{
@@ -689,6 +878,218 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnorePatterns(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tags     KeyValueTags
+		patterns []*regexp.Regexp
+		want     map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			patterns: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			patterns: []*regexp.Regexp{
+				regexp.MustCompile(`.*`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "unanchored",
+			tags: New(map[string]string{
+				"key1":   "value1",
+				"my-key": "value2",
+				"other":  "value3",
+			}),
+			patterns: []*regexp.Regexp{
+				regexp.MustCompile(`key`),
+			},
+			want: map[string]string{
+				"other": "value3",
+			},
+		},
+		{
+			name: "suffix",
+			tags: New(map[string]string{
+				"team-managed-by":     "value1",
+				"managed-by-team":     "value2",
+				"service-managed-by":  "value3",
+				"scanner:2024:result": "value4",
+			}),
+			patterns: []*regexp.Regexp{
+				regexp.MustCompile(`-managed-by$`),
+			},
+			want: map[string]string{
+				"managed-by-team":     "value2",
+				"scanner:2024:result": "value4",
+			},
+		},
+		{
+			name: "multiple",
+			tags: New(map[string]string{
+				"scanner:2024-10:result": "value1",
+				"scanner:latest:result":  "value2",
+				"team-managed-by":        "value3",
+			}),
+			patterns: []*regexp.Regexp{
+				regexp.MustCompile(`^scanner:\d{4}-\d{2}:result$`),
+				regexp.MustCompile(`-managed-by$`),
+			},
+			want: map[string]string{
+				"scanner:latest:result": "value2",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			patterns: []*regexp.Regexp{
+				regexp.MustCompile(`^Key`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnorePatterns(testCase.patterns)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreValuePatterns(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		valuePatterns []*IgnoreValuePattern
+		want          map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{
+				"Name": "value1",
+			}),
+			valuePatterns: nil,
+			want: map[string]string{
+				"Name": "value1",
+			},
+		},
+		{
+			name: "matching value",
+			tags: New(map[string]string{
+				"Name": "eks-node",
+				"key1": "value1",
+			}),
+			valuePatterns: []*IgnoreValuePattern{
+				{
+					Key:     "Name",
+					Pattern: regexp.MustCompile(`^eks-`),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "non-matching value",
+			tags: New(map[string]string{
+				"Name": "web-server",
+				"key1": "value1",
+			}),
+			valuePatterns: []*IgnoreValuePattern{
+				{
+					Key:     "Name",
+					Pattern: regexp.MustCompile(`^eks-`),
+				},
+			},
+			want: map[string]string{
+				"Name": "web-server",
+				"key1": "value1",
+			},
+		},
+		{
+			name: "matching value of other key",
+			tags: New(map[string]string{
+				"Name":  "web-server",
+				"Owner": "eks-controller",
+			}),
+			valuePatterns: []*IgnoreValuePattern{
+				{
+					Key:     "Name",
+					Pattern: regexp.MustCompile(`^eks-`),
+				},
+			},
+			want: map[string]string{
+				"Name":  "web-server",
+				"Owner": "eks-controller",
+			},
+		},
+		{
+			name: "multiple patterns for key",
+			tags: New(map[string]string{
+				"Name":  "ecs-task",
+				"Owner": "eks-controller",
+			}),
+			valuePatterns: []*IgnoreValuePattern{
+				{
+					Key:     "Name",
+					Pattern: regexp.MustCompile(`^eks-`),
+				},
+				{
+					Key:     "Name",
+					Pattern: regexp.MustCompile(`^ecs-`),
+				},
+				{
+					Key:     "Owner",
+					Pattern: regexp.MustCompile(`controller$`),
+				},
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "empty value",
+			tags: New(map[string]string{
+				"Name": "",
+			}),
+			valuePatterns: []*IgnoreValuePattern{
+				{
+					Key:     "Name",
+					Pattern: regexp.MustCompile(`^$`),
+				},
+			},
+			want: map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreValuePatterns(testCase.valuePatterns)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreRds(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -46,6 +46,12 @@ func TestGetResourceID(t *testing.T) {
 			ExpectedIdentifier: "testidentifier",
 			ExpectedKey:        "testkey",
 		},
+		{
+			Description:        "key with separator",
+			ResourceIdentifier: "testidentifier,test,key",
+			ExpectedIdentifier: "testidentifier",
+			ExpectedKey:        "test,key",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -104,3 +110,47 @@ func TestSetResourceId(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceIDRoundTrip(t *testing.T) {
+	testCases := []struct {
+		Description string
+		Identifier  string
+		Key         string
+	}{
+		{
+			Description: "ARN",
+			Identifier:  "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			Key:         "Name",
+		},
+		{
+			Description: "URL",
+			Identifier:  "https://sqs.us-west-2.amazonaws.com/123456789012/example", //lintignore:AWSAT003
+			Key:         "Name",
+		},
+		{
+			Description: "key with separator",
+			Identifier:  "vpc-12345678",
+			Key:         "a,b",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Description, func(t *testing.T) {
+			gotIdentifier, gotKey, err := GetResourceID(SetResourceID(testCase.Identifier, testCase.Key))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotIdentifier != testCase.Identifier {
+				t.Errorf("got identifier %s, expected identifier %s", gotIdentifier, testCase.Identifier)
+			}
+
+			if gotKey != testCase.Key {
+				t.Errorf("got key %s, expected key %s", gotKey, testCase.Key)
+			}
+		})
+	}
+}
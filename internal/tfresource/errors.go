@@ -15,13 +15,19 @@ func NotFound(err error) bool {
 }
 
 // TimedOut returns true if the error represents a "wait timed out" condition.
-// Specifically, TimedOut returns true if the error matches all these conditions:
-//  * err is of type resource.TimeoutError
-//  * TimeoutError.LastError is nil
+// Specifically, TimedOut returns true if the error matches one of these conditions:
+//  * err is of type resource.TimeoutError and TimeoutError.LastError is nil
+//  * err is of type WaitForStatusTimeoutError
 func TimedOut(err error) bool {
 	// This explicitly does *not* match wrapped TimeoutErrors
-	timeoutErr, ok := err.(*resource.TimeoutError) //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
-	return ok && timeoutErr.LastError == nil
+	switch err := err.(type) { //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
+	case *resource.TimeoutError:
+		return err.LastError == nil
+	case *WaitForStatusTimeoutError:
+		return true
+	}
+
+	return false
 }
 
 // SetLastError sets the LastError field on the error if supported.
@@ -82,6 +82,15 @@ func TestTimedOut(t *testing.T) {
 			Name: "wrapped timeout error",
 			Err:  fmt.Errorf("test: %w", &resource.TimeoutError{}),
 		},
+		{
+			Name:     "wait for status timeout error",
+			Err:      &tfresource.WaitForStatusTimeoutError{},
+			Expected: true,
+		},
+		{
+			Name: "wrapped wait for status timeout error",
+			Err:  fmt.Errorf("test: %w", &tfresource.WaitForStatusTimeoutError{}),
+		},
 		{
 			Name: "wrapped timeout error non-nil last error",
 			Err:  fmt.Errorf("test: %w", &resource.TimeoutError{LastError: errors.New("test")}),
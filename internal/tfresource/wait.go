@@ -2,6 +2,10 @@ package tfresource
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -62,3 +66,199 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 func WaitUntil(timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
 	return WaitUntilContext(context.Background(), timeout, f, opts)
 }
+
+// FindFunc returns the API object being waited on.
+// A "not found" error, as determined by NotFound, indicates that the API object does not exist.
+type FindFunc func() (interface{}, error)
+
+// StatusFunc returns the status of an API object returned by a FindFunc.
+type StatusFunc func(interface{}) string
+
+type WaitForStatusOpts struct {
+	Name             string        // Name of the API object in progress messages, e.g. "EKS Cluster (example)".
+	Pending          []string      // Statuses to keep waiting in.
+	Target           []string      // Statuses to stop waiting in.
+	PendingNotFound  bool          // Keep waiting if the API object is not found, e.g. for eventual consistency after creation.
+	TargetNotFound   bool          // Stop waiting if the API object is not found, e.g. after deletion.
+	Delay            time.Duration // Wait this time before starting checks.
+	MinTimeout       time.Duration // Smallest time to wait between checks.
+	PollInterval     time.Duration // Override MinTimeout/backoff and only check this often.
+	ProgressInterval time.Duration // Log a progress message this often. Defaults to 30 seconds.
+}
+
+const (
+	waitForStatusDefaultProgressInterval = 30 * time.Second
+	waitForStatusInitialInterval         = 100 * time.Millisecond
+	waitForStatusMaxInterval             = 10 * time.Second
+)
+
+// WaitForStatusTimeoutError is returned by WaitForStatusContext if the timeout is exceeded.
+// LastStatus is the last status observed; it is empty if the API object was not found.
+type WaitForStatusTimeoutError struct {
+	Elapsed    time.Duration
+	LastStatus string
+	NotFound   bool
+	Target     []string
+	Timeout    time.Duration
+}
+
+func (e *WaitForStatusTimeoutError) Error() string {
+	lastStatus := fmt.Sprintf("last status: %q", e.LastStatus)
+
+	if e.NotFound {
+		lastStatus = "last status: not found"
+	}
+
+	return fmt.Sprintf("timeout while waiting for status to become %s (%s, timeout: %s)", quoteStatuses(e.Target), lastStatus, e.Timeout)
+}
+
+// WaitForStatusContext waits for the API object returned by `find` to have one of the `opts.Target` statuses,
+// as returned by `status`, and returns the API object.
+// If `find` returns an error other than "not found", or the status is neither pending nor target, return immediately with an error.
+// If `timeout` is exceeded, return a *WaitForStatusTimeoutError with the last observed status.
+// Waits between calls to `find` using jittered exponential backoff and logs progress every `opts.ProgressInterval`.
+func WaitForStatusContext(ctx context.Context, timeout time.Duration, find FindFunc, status StatusFunc, opts WaitForStatusOpts) (interface{}, error) {
+	name := opts.Name
+
+	if name == "" {
+		name = "resource"
+	}
+
+	progressInterval := opts.ProgressInterval
+
+	if progressInterval <= 0 {
+		progressInterval = waitForStatusDefaultProgressInterval
+	}
+
+	start := time.Now()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	var lastStatus string
+	var notFound bool
+
+	timeoutErr := func() error {
+		return &WaitForStatusTimeoutError{
+			Elapsed:    time.Since(start),
+			LastStatus: lastStatus,
+			NotFound:   notFound,
+			Target:     opts.Target,
+			Timeout:    timeout,
+		}
+	}
+
+	sleep := func(d time.Duration) error {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline.C:
+			return timeoutErr()
+		case <-timer.C:
+			return nil
+		}
+	}
+
+	if opts.Delay > 0 {
+		if err := sleep(opts.Delay); err != nil {
+			return nil, err
+		}
+	}
+
+	interval := waitForStatusInitialInterval
+	lastProgress := start
+
+	for {
+		output, err := find()
+
+		switch {
+		case NotFound(err):
+			if opts.TargetNotFound {
+				return nil, nil
+			}
+
+			if !opts.PendingNotFound {
+				return nil, err
+			}
+
+			lastStatus, notFound = "", true
+		case err != nil:
+			return output, err
+		default:
+			lastStatus, notFound = status(output), false
+
+			if statusIn(lastStatus, opts.Target) {
+				return output, nil
+			}
+
+			if !statusIn(lastStatus, opts.Pending) {
+				return output, &resource.UnexpectedStateError{
+					State:         lastStatus,
+					ExpectedState: opts.Target,
+				}
+			}
+		}
+
+		if elapsed := time.Since(start); time.Since(lastProgress) >= progressInterval {
+			lastProgress = time.Now()
+
+			if notFound {
+				log.Printf("[INFO] Waiting for %s status to become %s (not found, elapsed: %s)", name, quoteStatuses(opts.Target), elapsed.Round(time.Second))
+			} else {
+				log.Printf("[INFO] Waiting for %s status to become %s (current status: %q, elapsed: %s)", name, quoteStatuses(opts.Target), lastStatus, elapsed.Round(time.Second))
+			}
+		}
+
+		wait := opts.PollInterval
+
+		if wait <= 0 {
+			wait = interval
+
+			if wait < opts.MinTimeout {
+				wait = opts.MinTimeout
+			}
+
+			if interval *= 2; interval > waitForStatusMaxInterval {
+				interval = waitForStatusMaxInterval
+			}
+
+			// Spread out calls from concurrent waiters to reduce API throttling.
+			wait += time.Duration(rand.Int63n(int64(wait)/5 + 1))
+		}
+
+		if err := sleep(wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// WaitForStatus waits for the API object returned by `find` to have one of the `opts.Target` statuses,
+// as returned by `status`, and returns the API object.
+// If `find` returns an error other than "not found", or the status is neither pending nor target, return immediately with an error.
+// If `timeout` is exceeded, return a *WaitForStatusTimeoutError with the last observed status.
+// Waits between calls to `find` using jittered exponential backoff and logs progress every `opts.ProgressInterval`.
+func WaitForStatus(timeout time.Duration, find FindFunc, status StatusFunc, opts WaitForStatusOpts) (interface{}, error) {
+	return WaitForStatusContext(context.Background(), timeout, find, status, opts)
+}
+
+func statusIn(status string, statuses []string) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func quoteStatuses(statuses []string) string {
+	quoted := make([]string, len(statuses))
+
+	for i, s := range statuses {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+
+	return strings.Join(quoted, ", ")
+}
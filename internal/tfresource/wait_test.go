@@ -1,11 +1,17 @@
 package tfresource_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"log"
+	"os"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -63,3 +69,265 @@ func TestWaitUntil(t *testing.T) {
 		})
 	}
 }
+
+type fakeThing struct {
+	Status string
+}
+
+// fakeFinder returns a FindFunc returning each of the results in turn, repeating the last.
+// A string result is returned as a *fakeThing with that status and an error result is returned as is.
+func fakeFinder(results ...interface{}) (tfresource.FindFunc, *int32) {
+	var calls int32
+
+	return func() (interface{}, error) {
+		i := int(atomic.AddInt32(&calls, 1)) - 1
+
+		if i >= len(results) {
+			i = len(results) - 1
+		}
+
+		switch v := results[i].(type) {
+		case error:
+			return nil, v
+		default:
+			return &fakeThing{Status: v.(string)}, nil
+		}
+	}, &calls
+}
+
+func fakeThingStatus(v interface{}) string {
+	return v.(*fakeThing).Status
+}
+
+func TestWaitForStatus(t *testing.T) {
+	notFoundErr := &resource.NotFoundError{}
+	otherErr := errors.New("test")
+
+	testCases := []struct {
+		Name               string
+		Results            []interface{}
+		Opts               tfresource.WaitForStatusOpts
+		ExpectedStatus     string
+		ExpectedCalls      int32
+		ExpectedErr        error
+		ExpectedLastStatus string
+		ExpectedNotFound   bool
+		ExpectTimeout      bool
+		ExpectUnexpected   bool
+	}{
+		{
+			Name:           "immediate target",
+			Results:        []interface{}{"AVAILABLE"},
+			ExpectedStatus: "AVAILABLE",
+			ExpectedCalls:  1,
+		},
+		{
+			Name:           "pending then target",
+			Results:        []interface{}{"CREATING", "CREATING", "AVAILABLE"},
+			ExpectedStatus: "AVAILABLE",
+			ExpectedCalls:  3,
+		},
+		{
+			Name:           "not found pending then target",
+			Results:        []interface{}{notFoundErr, "CREATING", "AVAILABLE"},
+			Opts:           tfresource.WaitForStatusOpts{PendingNotFound: true},
+			ExpectedStatus: "AVAILABLE",
+			ExpectedCalls:  3,
+		},
+		{
+			Name:          "not found",
+			Results:       []interface{}{notFoundErr},
+			ExpectedErr:   notFoundErr,
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "not found target",
+			Results:       []interface{}{"DELETING", notFoundErr},
+			Opts:          tfresource.WaitForStatusOpts{TargetNotFound: true},
+			ExpectedCalls: 2,
+		},
+		{
+			Name:          "find error",
+			Results:       []interface{}{"CREATING", otherErr},
+			ExpectedErr:   otherErr,
+			ExpectedCalls: 2,
+		},
+		{
+			Name:             "unexpected status",
+			Results:          []interface{}{"CREATING", "FAILED"},
+			ExpectUnexpected: true,
+			ExpectedCalls:    2,
+		},
+		{
+			Name:               "timeout",
+			Results:            []interface{}{"CREATING"},
+			ExpectTimeout:      true,
+			ExpectedLastStatus: "CREATING",
+		},
+		{
+			Name:             "timeout not found",
+			Results:          []interface{}{"CREATING", notFoundErr},
+			Opts:             tfresource.WaitForStatusOpts{PendingNotFound: true},
+			ExpectTimeout:    true,
+			ExpectedNotFound: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			find, calls := fakeFinder(testCase.Results...)
+			opts := testCase.Opts
+			opts.Pending = []string{"CREATING", "DELETING"}
+			opts.Target = []string{"AVAILABLE"}
+			opts.PollInterval = 10 * time.Millisecond
+
+			output, err := tfresource.WaitForStatus(200*time.Millisecond, find, fakeThingStatus, opts)
+
+			switch {
+			case testCase.ExpectTimeout:
+				var timeoutErr *tfresource.WaitForStatusTimeoutError
+
+				if !errors.As(err, &timeoutErr) {
+					t.Fatalf("expected *WaitForStatusTimeoutError, got: %v", err)
+				}
+
+				if !tfresource.TimedOut(err) {
+					t.Errorf("expected TimedOut to be true")
+				}
+
+				if timeoutErr.LastStatus != testCase.ExpectedLastStatus {
+					t.Errorf("got last status %q, expected %q", timeoutErr.LastStatus, testCase.ExpectedLastStatus)
+				}
+
+				if timeoutErr.NotFound != testCase.ExpectedNotFound {
+					t.Errorf("got not found %t, expected %t", timeoutErr.NotFound, testCase.ExpectedNotFound)
+				}
+
+				return
+			case testCase.ExpectUnexpected:
+				var unexpectedErr *resource.UnexpectedStateError
+
+				if !errors.As(err, &unexpectedErr) {
+					t.Fatalf("expected *resource.UnexpectedStateError, got: %v", err)
+				}
+			case testCase.ExpectedErr != nil:
+				if !errors.Is(err, testCase.ExpectedErr) {
+					t.Fatalf("got error %v, expected %v", err, testCase.ExpectedErr)
+				}
+			case err != nil:
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := atomic.LoadInt32(calls); got != testCase.ExpectedCalls {
+				t.Errorf("got %d calls, expected %d", got, testCase.ExpectedCalls)
+			}
+
+			if testCase.ExpectedStatus == "" {
+				return
+			}
+
+			thing, ok := output.(*fakeThing)
+
+			if !ok {
+				t.Fatalf("expected *fakeThing, got %T", output)
+			}
+
+			if thing.Status != testCase.ExpectedStatus {
+				t.Errorf("got status %q, expected %q", thing.Status, testCase.ExpectedStatus)
+			}
+		})
+	}
+}
+
+func TestWaitForStatusContextCanceled(t *testing.T) {
+	find, _ := fakeFinder("CREATING")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := tfresource.WaitForStatusContext(ctx, time.Minute, find, fakeThingStatus, tfresource.WaitForStatusOpts{
+		Pending:      []string{"CREATING"},
+		Target:       []string{"AVAILABLE"},
+		PollInterval: 10 * time.Millisecond,
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, expected %v", err, context.Canceled)
+	}
+
+	if tfresource.TimedOut(err) {
+		t.Errorf("expected TimedOut to be false")
+	}
+}
+
+func TestWaitForStatusProgress(t *testing.T) {
+	var buf bytes.Buffer
+
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	find, _ := fakeFinder(&resource.NotFoundError{}, "CREATING", "CREATING", "CREATING", "AVAILABLE")
+
+	_, err := tfresource.WaitForStatus(time.Minute, find, fakeThingStatus, tfresource.WaitForStatusOpts{
+		Name:             "Test Thing (test)",
+		Pending:          []string{"CREATING"},
+		Target:           []string{"AVAILABLE"},
+		PendingNotFound:  true,
+		PollInterval:     10 * time.Millisecond,
+		ProgressInterval: time.Nanosecond,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := buf.String()
+
+	for _, expected := range []string{
+		`[INFO] Waiting for Test Thing (test) status to become "AVAILABLE" (not found, elapsed: `,
+		`[INFO] Waiting for Test Thing (test) status to become "AVAILABLE" (current status: "CREATING", elapsed: `,
+	} {
+		if !strings.Contains(got, expected) {
+			t.Errorf("expected log output to contain %q, got:\n%s", expected, got)
+		}
+	}
+
+	if n := strings.Count(got, "[INFO] Waiting for Test Thing (test)"); n != 4 {
+		t.Errorf("got %d progress messages, expected 4", n)
+	}
+}
+
+func TestWaitForStatusTimeoutError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      *tfresource.WaitForStatusTimeoutError
+		Expected string
+	}{
+		{
+			Name: "last status",
+			Err: &tfresource.WaitForStatusTimeoutError{
+				LastStatus: "CREATING",
+				Target:     []string{"AVAILABLE", "ACTIVE"},
+				Timeout:    20 * time.Minute,
+			},
+			Expected: `timeout while waiting for status to become "AVAILABLE", "ACTIVE" (last status: "CREATING", timeout: 20m0s)`,
+		},
+		{
+			Name: "not found",
+			Err: &tfresource.WaitForStatusTimeoutError{
+				NotFound: true,
+				Target:   []string{"AVAILABLE"},
+				Timeout:  time.Minute,
+			},
+			Expected: `timeout while waiting for status to become "AVAILABLE" (last status: not found, timeout: 1m0s)`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Err.Error(); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -20,7 +20,9 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+// Resource tags, including those defaulted at the provider-level, are also
+// checked against the provider-level "required_tags" configuration.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -30,6 +32,10 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
+	if err := checkRequiredTags(ctx, diff, meta.(*conns.AWSClient).RequiredTagsConfig, defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+		return err
+	}
+
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
@@ -55,6 +61,29 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// checkRequiredTags returns an error if the tags of a new resource, or the
+// changed tags of an existing resource, do not satisfy the required tags
+// configuration. Existing resources are not checked until their tags change
+// so that they can still be planned after required tags are configured.
+func checkRequiredTags(ctx context.Context, diff *schema.ResourceDiff, requiredTagsConfig *tftags.RequiredConfig, tags tftags.KeyValueTags) error {
+	if requiredTagsConfig == nil {
+		return nil
+	}
+
+	// Tags are not checked until all of them are known.
+	if !diff.NewValueKnown("tags") || !diff.NewValueKnown("tags.%") {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChange("tags") {
+		return nil
+	}
+
+	resourceType, _ := conns.ResourceTypeFromContext(ctx)
+
+	return requiredTagsConfig.Validate(resourceType, tags)
+}
+
 func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
 	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
 	if err != nil {
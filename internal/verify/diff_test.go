@@ -1,10 +1,16 @@
 package verify
 
 import (
+	"context"
 	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestSuppressEquivalentJSONDiffsWhitespaceAndNoWhitespace(t *testing.T) {
@@ -362,3 +368,135 @@ func TestDiffStringMaps(t *testing.T) {
 		}
 	}
 }
+
+func TestSetTagsDiffRequiredTags(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+		CustomizeDiff: SetTagsDiff,
+	}
+
+	requiredTagsConfig := &tftags.RequiredConfig{
+		Tags: []*tftags.RequiredTag{
+			{
+				Key:           "cost-center",
+				AllowedValues: []*regexp.Regexp{regexp.MustCompile(`^\d{4}$`)},
+			},
+			{
+				Key:                  "owner",
+				ExcludeResourceTypes: []string{"aws_excluded"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		resourceType      string
+		defaultTagsConfig *tftags.DefaultConfig
+		state             *terraform.InstanceState
+		tags              map[string]interface{}
+		wantErr           string
+	}{
+		{
+			name:         "create with required tags",
+			resourceType: "aws_test",
+			tags:         map[string]interface{}{"cost-center": "1234", "owner": "team"},
+		},
+		{
+			name:         "create with missing tags",
+			resourceType: "aws_test",
+			tags:         map[string]interface{}{"cost-center": "1234"},
+			wantErr:      "missing required tag keys: owner",
+		},
+		{
+			name:         "create with disallowed value",
+			resourceType: "aws_test",
+			tags:         map[string]interface{}{"cost-center": "none", "owner": "team"},
+			wantErr:      `tag "cost-center" value "none" does not match any allowed value`,
+		},
+		{
+			name:         "create with default tags",
+			resourceType: "aws_test",
+			defaultTagsConfig: &tftags.DefaultConfig{
+				Tags: tftags.New(map[string]interface{}{"owner": "team"}),
+			},
+			tags: map[string]interface{}{"cost-center": "1234"},
+		},
+		{
+			name:         "create excluded resource type",
+			resourceType: "aws_excluded",
+			tags:         map[string]interface{}{"cost-center": "1234"},
+		},
+		{
+			name:         "create with unknown value",
+			resourceType: "aws_test",
+			// The value is not known until apply.
+			tags: map[string]interface{}{"cost-center": "74D93920-ED26-11E3-AC10-0800200C9A66", "owner": "team"},
+		},
+		{
+			name:         "existing resource with unchanged tags",
+			resourceType: "aws_test",
+			state: &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"id":            "test",
+					"tags.%":        "1",
+					"tags.Name":     "test",
+					"tags_all.%":    "1",
+					"tags_all.Name": "test",
+				},
+			},
+			tags: map[string]interface{}{"Name": "test"},
+		},
+		{
+			name:         "existing resource with changed tags",
+			resourceType: "aws_test",
+			state: &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"id":            "test",
+					"tags.%":        "1",
+					"tags.Name":     "test",
+					"tags_all.%":    "1",
+					"tags_all.Name": "test",
+				},
+			},
+			tags:    map[string]interface{}{"Name": "test", "cost-center": "1234"},
+			wantErr: "missing required tag keys: owner",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			meta := &conns.AWSClient{
+				DefaultTagsConfig:  testCase.defaultTagsConfig,
+				RequiredTagsConfig: requiredTagsConfig,
+			}
+
+			ctx := conns.NewResourceTypeContext(context.Background(), testCase.resourceType)
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"tags": testCase.tags,
+			})
+
+			_, err := r.Diff(ctx, testCase.state, config, meta)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing: %s", testCase.wantErr)
+			}
+
+			if !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("got error %q, expected it to contain %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
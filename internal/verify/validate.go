@@ -229,6 +229,14 @@ func ValidOnceAWeekWindowFormat(v interface{}, k string) (ws []string, errors []
 	return
 }
 
+func ValidRegionName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regionRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid region name (expecting to match regular expression: %s)", k, value, regionRegexp))
+	}
+	return
+}
+
 func ValidStringIsJSONOrYAML(v interface{}, k string) (ws []string, errors []error) {
 	if looksLikeJsonString(v) {
 		if _, err := structure.NormalizeJsonString(v); err != nil {
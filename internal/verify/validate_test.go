@@ -440,6 +440,35 @@ func TestValidOnceAWeekWindowFormat(t *testing.T) {
 	}
 }
 
+func TestValidRegionName(t *testing.T) {
+	validNames := []string{
+		"ap-northeast-1",
+		"us-east-1",
+		"us-gov-west-1",
+		"cn-northwest-1",
+	}
+	for _, v := range validNames {
+		_, errors := ValidRegionName(v, "region")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid AWS region name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"us-east",
+		"US-EAST-1",
+		"us-east-1a",
+		"useast1",
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidRegionName(v, "region")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid AWS region name", v)
+		}
+	}
+}
+
 func TestValidOnceADayWindowFormat(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -0,0 +1,171 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/crudfuncinfo"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for d.SetId("") in Read functions without d.IsNewResource() check
+
+The AWSR003 analyzer reports when a (schema.ResourceData).SetId("") call in a
+resource Read function is not inside a conditional that checks
+(schema.ResourceData).IsNewResource().
+
+Removing a resource from state during Read is only expected for existing
+resources that have been deleted outside Terraform. For new resources, Read
+should return an error so eventual consistency issues after Create are not
+silently hidden.
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		crudfuncinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	crudFuncs := pass.ResultOf[crudfuncinfo.Analyzer].([]*schema.CRUDFuncInfo)
+
+	for _, crudFunc := range crudFuncs {
+		if !isResourceReadFunc(crudFunc) {
+			continue
+		}
+
+		var stack []ast.Node
+
+		ast.Inspect(crudFunc.Body, func(n ast.Node) bool {
+			if n == nil {
+				stack = stack[:len(stack)-1]
+				return true
+			}
+
+			stack = append(stack, n)
+
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			if !isResourceDataSetIdEmptyCallExpr(pass, callExpr) {
+				return true
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+				return true
+			}
+
+			if hasIsNewResourceCheck(pass, stack) {
+				return true
+			}
+
+			pass.Reportf(callExpr.Pos(), "%s: prefer !d.IsNewResource() check before d.SetId(\"\") in Read", analyzerName)
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isResourceReadFunc returns true if the CRUD function declaration is named
+// like a resource Read function, e.g. resourceExampleThingRead.
+// Data source Read functions and function literals are not checked.
+func isResourceReadFunc(crudFunc *schema.CRUDFuncInfo) bool {
+	if crudFunc.AstFuncDecl == nil {
+		return false
+	}
+
+	name := crudFunc.AstFuncDecl.Name.Name
+
+	if strings.HasPrefix(name, "dataSource") || strings.HasPrefix(name, "DataSource") {
+		return false
+	}
+
+	return strings.HasSuffix(name, "Read")
+}
+
+func isResourceDataSetIdEmptyCallExpr(pass *analysis.Pass, callExpr *ast.CallExpr) bool {
+	if !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "SetId") {
+		return false
+	}
+
+	if len(callExpr.Args) != 1 {
+		return false
+	}
+
+	id := astutils.ExprStringValue(callExpr.Args[0])
+
+	return id != nil && *id == ""
+}
+
+// hasIsNewResourceCheck returns true if the last node of the stack is inside
+// an if statement with a condition calling d.IsNewResource() or follows such an
+// if statement in an enclosing block, e.g.
+//
+//	if d.IsNewResource() {
+//		return fmt.Errorf("...")
+//	}
+//
+//	d.SetId("")
+func hasIsNewResourceCheck(pass *analysis.Pass, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch node := stack[i].(type) {
+		case *ast.BlockStmt:
+			for _, stmt := range node.List {
+				if stmt == stack[i+1] {
+					break
+				}
+
+				if ifStmt, ok := stmt.(*ast.IfStmt); ok && callsIsNewResource(pass, ifStmt.Cond) {
+					return true
+				}
+			}
+		case *ast.IfStmt:
+			// Only the body and else branches are guarded by the condition.
+			if stack[i+1] == node.Init || stack[i+1] == node.Cond {
+				continue
+			}
+
+			if callsIsNewResource(pass, node.Cond) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func callsIsNewResource(pass *analysis.Pass, expr ast.Expr) bool {
+	var found bool
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		if schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "IsNewResource") {
+			found = true
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
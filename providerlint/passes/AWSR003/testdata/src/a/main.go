@@ -0,0 +1,100 @@
+package a
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var errNotFound = errors.New("not found")
+
+func find(id string) error {
+	return errNotFound
+}
+
+func f() {
+	/* Passing cases */
+
+	_ = &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("")
+			return nil
+		},
+	}
+}
+
+func resourceExamplePassingRead(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	if !d.IsNewResource() && errors.Is(err, errNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if errors.Is(err, errNotFound) {
+		if !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	if d.IsNewResource() {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceExamplePassingDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+
+	return nil
+}
+
+func dataSourceExamplePassingRead(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+
+	return nil
+}
+
+/* Comment ignored cases */
+
+func resourceExampleIgnoredRead(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	if errors.Is(err, errNotFound) {
+		//lintignore:AWSR003
+		d.SetId("")
+		return nil
+	}
+
+	if errors.Is(err, errNotFound) {
+		d.SetId("") //lintignore:AWSR003
+		return nil
+	}
+
+	return err
+}
+
+/* Failing cases */
+
+func resourceExampleFailingRead(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	if errors.Is(err, errNotFound) {
+		d.SetId("") // want "prefer !d.IsNewResource\\(\\) check before d.SetId\\(\"\"\\) in Read"
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("") // want "prefer !d.IsNewResource\\(\\) check before d.SetId\\(\"\"\\) in Read"
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/crudfuncinfo"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for AWS Go SDK calls without context in context-aware CRUD functions
+
+The AWSR004 analyzer reports when a CreateContext, ReadContext, UpdateContext
+or DeleteContext function calls an AWS Go SDK service client method, such as
+DescribeThing(), for which a WithContext variant, such as
+DescribeThingWithContext(), is available.
+
+Passing the CRUD function's context to the AWS Go SDK allows requests to be
+cancelled, for example when Terraform is interrupted.
+`
+
+const analyzerName = "AWSR004"
+
+const awsSDKServicePackagePathPrefix = `github.com/aws/aws-sdk-go/service/`
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		crudfuncinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	crudFuncs := pass.ResultOf[crudfuncinfo.Analyzer].([]*schema.CRUDFuncInfo)
+
+	for _, crudFunc := range crudFuncs {
+		if !astutils.IsFieldListTypePackageType(crudFunc.Type.Params, 0, pass.TypesInfo, "context", "Context") {
+			continue
+		}
+
+		ast.Inspect(crudFunc.Body, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+
+			if !ok {
+				return true
+			}
+
+			methodName := selectorExpr.Sel.Name
+
+			if strings.HasSuffix(methodName, "WithContext") {
+				return true
+			}
+
+			if !hasWithContextMethod(pass.TypesInfo.TypeOf(selectorExpr.X), methodName) {
+				return true
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+				return true
+			}
+
+			pass.Reportf(callExpr.Pos(), "%s: prefer %sWithContext() in context-aware CRUD function", analyzerName, methodName)
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// hasWithContextMethod returns true if the type is an AWS Go SDK service
+// client, or service client interface, with a <methodName>WithContext method.
+func hasWithContextMethod(t types.Type, methodName string) bool {
+	if t == nil {
+		return false
+	}
+
+	namedType := t
+
+	if pointerType, ok := t.(*types.Pointer); ok {
+		namedType = pointerType.Elem()
+	}
+
+	named, ok := namedType.(*types.Named)
+
+	if !ok {
+		return false
+	}
+
+	pkg := named.Obj().Pkg()
+
+	if pkg == nil || !strings.Contains(pkg.Path(), awsSDKServicePackagePathPrefix) {
+		return false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(t, true, pkg, methodName+"WithContext")
+
+	_, ok = obj.(*types.Func)
+
+	return ok
+}
@@ -0,0 +1,123 @@
+package a
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() {
+	/* Passing cases */
+
+	_ = &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			conn := meta.(*s3.S3)
+
+			_, err := conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(d.Id())})
+
+			return err
+		},
+	}
+
+	/* Failing cases */
+
+	_ = &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			conn := meta.(*s3.S3)
+
+			_, err := conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(d.Id())}) // want "prefer HeadBucketWithContext\\(\\) in context-aware CRUD function"
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceExamplePassingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*s3.S3)
+
+	_, err := conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(d.Id())})
+
+	return err
+}
+
+func resourceExamplePassingReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*s3.S3)
+
+	_, err := conn.HeadBucketWithContext(ctx, &s3.HeadBucketInput{Bucket: aws.String(d.Id())})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = conn.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{Bucket: aws.String(d.Id())}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// No WithContext variant.
+	req, _ := conn.HeadBucketRequest(&s3.HeadBucketInput{Bucket: aws.String(d.Id())})
+	req.SetContext(ctx)
+
+	if err := req.Send(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+/* Comment ignored cases */
+
+func resourceExampleIgnoredDeleteContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*s3.S3)
+
+	//lintignore:AWSR004
+	_, err := conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(d.Id())})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(d.Id())}) //lintignore:AWSR004
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+/* Failing cases */
+
+func resourceExampleFailingCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*s3.S3)
+
+	_, err := conn.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String(d.Get("bucket").(string))}) // want "prefer CreateBucketWithContext\\(\\) in context-aware CRUD function"
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = conn.ListObjectsV2Pages(&s3.ListObjectsV2Input{Bucket: aws.String(d.Id())}, func(page *s3.ListObjectsV2Output, lastPage bool) bool { // want "prefer ListObjectsV2PagesWithContext\\(\\) in context-aware CRUD function"
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := conn.WaitUntilBucketExists(&s3.HeadBucketInput{Bucket: aws.String(d.Id())}); err != nil { // want "prefer WaitUntilBucketExistsWithContext\\(\\) in context-aware CRUD function"
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
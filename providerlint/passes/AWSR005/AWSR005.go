@@ -0,0 +1,183 @@
+package AWSR005
+
+import (
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for fmt.Errorf() calls formatting errors with %s or %v
+
+The AWSR005 analyzer reports when a fmt.Errorf() call formats an error
+argument with the %s or %v verb instead of the %w verb. Wrapping the error
+with %w keeps the original error available to errors.Is() and errors.As(),
+for example when checking AWS Go SDK error codes with tfawserr.
+
+Any argument implementing the error interface is checked, not only
+awserr.Error, as most AWS Go SDK errors are typed as error.
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	ignorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	errorType := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+	nodeFilter := []ast.Node{
+		(*ast.CallExpr)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		callExpr := n.(*ast.CallExpr)
+
+		if !astutils.IsStdlibPackageFunc(callExpr.Fun, pass.TypesInfo, "fmt", "Errorf") {
+			return
+		}
+
+		if len(callExpr.Args) < 2 || callExpr.Ellipsis.IsValid() {
+			return
+		}
+
+		if ignorer.ShouldIgnore(analyzerName, callExpr) {
+			return
+		}
+
+		format := astutils.ExprStringValue(callExpr.Args[0])
+
+		if format == nil {
+			return
+		}
+
+		verbs := argumentVerbs(*format)
+
+		// Only a single %w verb is supported per fmt.Errorf() call.
+		for _, verb := range verbs {
+			if verb == 'w' {
+				return
+			}
+		}
+
+		for i, arg := range callExpr.Args[1:] {
+			if verb, ok := verbs[i]; !ok || (verb != 's' && verb != 'v') {
+				continue
+			}
+
+			argType := pass.TypesInfo.TypeOf(arg)
+
+			if argType == nil || !types.Implements(argType, errorType) {
+				continue
+			}
+
+			pass.Reportf(arg.Pos(), "%s: prefer %%w verb for error argument in fmt.Errorf()", analyzerName)
+
+			return
+		}
+	})
+	return nil, nil
+}
+
+// argumentVerbs returns the verb used for each argument index of a format string.
+// Arguments consumed by * width or precision are not included.
+func argumentVerbs(format string) map[int]rune {
+	verbs := make(map[int]rune)
+	argNum := 0
+
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			i++
+			continue
+		}
+
+		i++
+
+		for i < len(format) && strings.IndexByte("+-# 0", format[i]) >= 0 {
+			i++
+		}
+
+		i, argNum = argumentIndex(format, i, argNum)
+
+		if i < len(format) && format[i] == '*' {
+			i++
+			argNum++
+		} else {
+			i = skipDigits(format, i)
+		}
+
+		if i < len(format) && format[i] == '.' {
+			i++
+			i, argNum = argumentIndex(format, i, argNum)
+
+			if i < len(format) && format[i] == '*' {
+				i++
+				argNum++
+			} else {
+				i = skipDigits(format, i)
+			}
+		}
+
+		i, argNum = argumentIndex(format, i, argNum)
+
+		if i >= len(format) {
+			break
+		}
+
+		verb, size := utf8.DecodeRuneInString(format[i:])
+		i += size
+
+		if verb == '%' {
+			continue
+		}
+
+		verbs[argNum] = verb
+		argNum++
+	}
+
+	return verbs
+}
+
+// argumentIndex parses an explicit [n] argument index at position i of the format string.
+func argumentIndex(format string, i int, argNum int) (int, int) {
+	if i >= len(format) || format[i] != '[' {
+		return i, argNum
+	}
+
+	end := strings.IndexByte(format[i:], ']')
+
+	if end < 0 {
+		return i, argNum
+	}
+
+	n, err := strconv.Atoi(format[i+1 : i+end])
+
+	if err != nil || n < 1 {
+		return i + end + 1, argNum
+	}
+
+	return i + end + 1, n - 1
+}
+
+func skipDigits(format string, i int) int {
+	for i < len(format) && format[i] >= '0' && format[i] <= '9' {
+		i++
+	}
+
+	return i
+}
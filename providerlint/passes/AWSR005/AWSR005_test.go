@@ -0,0 +1,57 @@
+package AWSR005
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR005(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "a")
+}
+
+func TestArgumentVerbs(t *testing.T) {
+	testCases := []struct {
+		Format   string
+		Expected map[int]rune
+	}{
+		{
+			Format:   "no verbs",
+			Expected: map[int]rune{},
+		},
+		{
+			Format:   "error reading (%s): %s",
+			Expected: map[int]rune{0: 's', 1: 's'},
+		},
+		{
+			Format:   "100%% of %q: %w",
+			Expected: map[int]rune{0: 'q', 1: 'w'},
+		},
+		{
+			Format:   "%-10s %+v %#x",
+			Expected: map[int]rune{0: 's', 1: 'v', 2: 'x'},
+		},
+		{
+			Format:   "%*d %.*f %s",
+			Expected: map[int]rune{1: 'd', 3: 'f', 4: 's'},
+		},
+		{
+			Format:   "%[2]s %[1]v",
+			Expected: map[int]rune{1: 's', 0: 'v'},
+		},
+		{
+			Format:   "trailing %",
+			Expected: map[int]rune{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Format, func(t *testing.T) {
+			if got := argumentVerbs(testCase.Format); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
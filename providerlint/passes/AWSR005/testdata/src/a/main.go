@@ -0,0 +1,49 @@
+package a
+
+import (
+	"errors"
+	"fmt"
+)
+
+type customError struct{}
+
+func (e *customError) Error() string {
+	return "custom"
+}
+
+func f() {
+	err := errors.New("test")
+	id := "test"
+	args := []interface{}{id, err}
+
+	/* Passing cases */
+
+	_ = fmt.Errorf("error reading (%s): %w", id, err)
+
+	_ = fmt.Errorf("error reading (%s)", id)
+
+	_ = fmt.Errorf("error reading (%d): %q", 1, err.Error())
+
+	_ = fmt.Errorf("error reading (%s): %w, %s", id, err, err)
+
+	_ = fmt.Errorf("error reading (%s): %s", args...)
+
+	_ = fmt.Errorf("100%% error: %[2]w (%[1]s)", id, err)
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	_ = fmt.Errorf("error reading (%s): %s", id, err)
+
+	_ = fmt.Errorf("error reading (%s): %s", id, err) //lintignore:AWSR005
+
+	/* Failing cases */
+
+	_ = fmt.Errorf("error reading (%s): %s", id, err) // want "prefer %w verb for error argument in fmt.Errorf\\(\\)"
+
+	_ = fmt.Errorf("error reading: %v", err) // want "prefer %w verb for error argument in fmt.Errorf\\(\\)"
+
+	_ = fmt.Errorf("error reading: %-10s", &customError{}) // want "prefer %w verb for error argument in fmt.Errorf\\(\\)"
+
+	_ = fmt.Errorf("error reading %[2]s: %[1]s", err, id) // want "prefer %w verb for error argument in fmt.Errorf\\(\\)"
+}
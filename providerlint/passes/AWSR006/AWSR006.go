@@ -0,0 +1,279 @@
+package AWSR006
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for provider resources without a sweeper
+
+The AWSR006 analyzer reports when a resource in the ResourcesMap of a
+schema.Provider is not registered as a sweeper with a
+resource.AddTestSweepers() call in the package implementing the resource.
+
+Sweepers remove resources left behind by failed acceptance tests. The package
+is found from the function returning the *schema.Resource, e.g.
+eks.ResourceAddon(), and all Go files in its directory are checked regardless
+of build constraints, as sweepers are typically in a sweep.go file with the
+sweep build tag.
+
+Resources removed by the sweeper of another resource, or that cannot be swept,
+are exempted via a lintignore comment on the ResourcesMap entry.
+
+Optional parameters:
+  - allowlist Path of a file listing resource types that are known to be
+    missing a sweeper, one per line. Entries are reported once the resource
+    has a sweeper.
+`
+
+const analyzerName = "AWSR006"
+
+var allowlistFile string
+
+func parseFlags() flag.FlagSet {
+	var flags = flag.NewFlagSet(analyzerName, flag.ExitOnError)
+	flags.StringVar(&allowlistFile, "allowlist", "", "Path of a file listing resource types known to be missing a sweeper")
+	return *flags
+}
+
+var Analyzer = &analysis.Analyzer{
+	Name:  analyzerName,
+	Doc:   Doc,
+	Flags: parseFlags(),
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	ignorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	allowlist, err := readAllowlist(allowlistFile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Sweeper names registered in each package directory.
+	sweepers := make(map[string]map[string]bool)
+
+	nodeFilter := []ast.Node{
+		(*ast.CompositeLit)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		x := n.(*ast.CompositeLit)
+
+		if !schema.IsTypeProvider(pass.TypesInfo.TypeOf(x)) {
+			return
+		}
+
+		resourcesMap := astutils.CompositeLitField(x, schema.ProviderFieldResourcesMap)
+
+		if resourcesMap == nil {
+			return
+		}
+
+		resourcesMapLit, ok := resourcesMap.Value.(*ast.CompositeLit)
+
+		if !ok {
+			return
+		}
+
+		for _, elt := range resourcesMapLit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+
+			if !ok {
+				continue
+			}
+
+			if isIgnored(ignorer, kv) {
+				continue
+			}
+
+			resourceType := astutils.ExprStringValue(kv.Key)
+
+			if resourceType == nil {
+				continue
+			}
+
+			dir := resourceFuncDir(pass, kv.Value)
+
+			if dir == "" {
+				continue
+			}
+
+			if _, ok := sweepers[dir]; !ok {
+				sweepers[dir] = registeredSweepers(dir)
+			}
+
+			if sweepers[dir][*resourceType] {
+				if allowlist[*resourceType] {
+					pass.Reportf(kv.Pos(), "%s: remove %s from the allowlist, it has a resource.AddTestSweepers() registration", analyzerName, *resourceType)
+				}
+
+				continue
+			}
+
+			if allowlist[*resourceType] {
+				continue
+			}
+
+			pass.Reportf(kv.Pos(), "%s: missing resource.AddTestSweepers() registration for %s", analyzerName, *resourceType)
+		}
+	})
+	return nil, nil
+}
+
+// isIgnored returns whether the ResourcesMap entry has a lintignore comment.
+// Comments at the end of the entry are associated with its innermost node, e.g.
+// the name of the function returning the *schema.Resource, so all of its nodes
+// are checked.
+func isIgnored(ignorer *commentignore.Ignorer, kv *ast.KeyValueExpr) bool {
+	ignored := false
+
+	ast.Inspect(kv, func(n ast.Node) bool {
+		if n != nil && ignorer.ShouldIgnore(analyzerName, n) {
+			ignored = true
+		}
+
+		return !ignored
+	})
+
+	return ignored
+}
+
+// resourceFuncDir returns the directory of the package declaring the function
+// called to return the *schema.Resource, e.g. eks.ResourceAddon().
+func resourceFuncDir(pass *analysis.Pass, e ast.Expr) string {
+	callExpr, ok := e.(*ast.CallExpr)
+
+	if !ok {
+		return ""
+	}
+
+	var ident *ast.Ident
+
+	switch fun := callExpr.Fun.(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	default:
+		return ""
+	}
+
+	obj := pass.TypesInfo.ObjectOf(ident)
+
+	if obj == nil || !obj.Pos().IsValid() {
+		return ""
+	}
+
+	filename := pass.Fset.Position(obj.Pos()).Filename
+
+	if filename == "" {
+		return ""
+	}
+
+	return filepath.Dir(filename)
+}
+
+// registeredSweepers returns the names passed to resource.AddTestSweepers()
+// calls in all Go files of the directory.
+func registeredSweepers(dir string) map[string]bool {
+	result := make(map[string]bool)
+
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return result
+	}
+
+	fset := token.NewFileSet()
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, 0)
+
+		if err != nil {
+			continue
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+
+			if !ok || selectorExpr.Sel.Name != "AddTestSweepers" || len(callExpr.Args) == 0 {
+				return true
+			}
+
+			if name := astutils.ExprStringValue(callExpr.Args[0]); name != nil {
+				result[*name] = true
+			}
+
+			return true
+		})
+	}
+
+	return result
+}
+
+// readAllowlist returns the resource types listed in the file, one per line.
+// Empty lines and lines starting with # are skipped.
+func readAllowlist(filename string) (map[string]bool, error) {
+	result := make(map[string]bool)
+
+	if filename == "" {
+		return result, nil
+	}
+
+	file, err := os.Open(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s allowlist: %w", analyzerName, err)
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		result[line] = true
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading %s allowlist: %w", analyzerName, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,18 @@
+package AWSR006
+
+import (
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR006(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	if err := Analyzer.Flags.Set("allowlist", filepath.Join(testdata, "allowlist.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, testdata, Analyzer, "a")
+}
@@ -0,0 +1,59 @@
+package a
+
+import (
+	"a/service/thing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_thing_widget": thing.DataSourceWidget(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			/* Passing cases */
+
+			"aws_example":     resourceExample(),
+			"aws_thing_thing": thing.ResourceThing(),
+
+			/* Comment ignored cases */
+
+			//lintignore:AWSR006
+			"aws_thing_gadget": thing.ResourceGadget(),
+
+			"aws_example_ignored": resourceExampleIgnored(), // lintignore:AWSR006
+
+			/* Allowlisted cases */
+
+			"aws_example_allowlisted": resourceExampleAllowlisted(),
+
+			/* Failing cases */
+
+			"aws_example_other": resourceExampleOther(), // want "missing resource.AddTestSweepers\\(\\) registration for aws_example_other"
+			"aws_thing_widget":  thing.ResourceWidget(), // want "missing resource.AddTestSweepers\\(\\) registration for aws_thing_widget"
+			"aws_example_swept": resourceExampleSwept(), // want "remove aws_example_swept from the allowlist, it has a resource.AddTestSweepers\\(\\) registration"
+		},
+	}
+}
+
+func resourceExample() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceExampleAllowlisted() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceExampleIgnored() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceExampleOther() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceExampleSwept() *schema.Resource {
+	return &schema.Resource{}
+}
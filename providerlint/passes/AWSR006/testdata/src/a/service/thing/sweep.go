@@ -0,0 +1,19 @@
+//go:build sweep
+// +build sweep
+
+package thing
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_thing_thing", &resource.Sweeper{
+		Name: "aws_thing_thing",
+		F:    sweepThings,
+	})
+}
+
+func sweepThings(region string) error {
+	return nil
+}
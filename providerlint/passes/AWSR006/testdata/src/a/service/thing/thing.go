@@ -0,0 +1,21 @@
+package thing
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceWidget() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func ResourceGadget() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func ResourceThing() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func ResourceWidget() *schema.Resource {
+	return &schema.Resource{}
+}
@@ -0,0 +1,24 @@
+//go:build sweep
+// +build sweep
+
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_example", &resource.Sweeper{
+		Name: "aws_example",
+		F:    sweepExamples,
+	})
+
+	resource.AddTestSweepers("aws_example_swept", &resource.Sweeper{
+		Name: "aws_example_swept",
+		F:    sweepExamples,
+	})
+}
+
+func sweepExamples(region string) error {
+	return nil
+}